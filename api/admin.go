@@ -0,0 +1,220 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// The largest request body accepted by the admin endpoints.
+	maxAdminRequestBytes = 1 << 20
+)
+
+// RepoAdmin serves the admin endpoints that change which repositories are served.
+//
+// Changes take effect in the cache immediately. They are only written to the configuration
+// file if the request asks for that with the "persist=true" URL parameter, in which case they
+// are written first, and not made at all if that fails.
+type RepoAdmin struct {
+	cache   *RepoCache
+	newRepo RepoFactory
+	// configPath, if set, is the configuration file that changes can be persisted to.
+	configPath string
+	// configMu serializes the changes, so that they are made in the same order as they are persisted.
+	configMu sync.Mutex
+}
+
+// NewRepoAdmin constructs the admin endpoints for the given cache.
+//
+// Repositories are opened with the given factory. If configPath is empty, then changes cannot be persisted.
+func NewRepoAdmin(cache *RepoCache, newRepo RepoFactory, configPath string) *RepoAdmin {
+	return &RepoAdmin{
+		cache:      cache,
+		newRepo:    newRepo,
+		configPath: configPath,
+	}
+}
+
+// isRemoved reports whether the repository at the given path was removed through the admin API.
+func (cache *RepoCache) isRemoved(path string) bool {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	return cache.removed[path]
+}
+
+// removeRepo stops serving the given repository, and keeps scans from adding it back.
+func (cache *RepoCache) removeRepo(repoDetails *RepoDetails) {
+	cache.mu.Lock()
+	cache.removed[repoDetails.Repo.GetPath()] = true
+	cache.mu.Unlock()
+	repoDetails.markGone()
+}
+
+// persist writes a change to the list of repositories to the configuration file, if the request
+// asks for that. The entries for the repository at the given path are replaced by the given entry,
+// or dropped if that is nil.
+//
+// The caller must hold the admin's configMu.
+func (admin *RepoAdmin) persist(r *http.Request, path string, repoConfig *RepoConfig) error {
+	if r.URL.Query().Get("persist") != "true" {
+		return nil
+	}
+	if admin.configPath == "" {
+		return errors.New("The server has no configuration file to persist changes to")
+	}
+	configDir, err := filepath.Abs(filepath.Dir(admin.configPath))
+	if err != nil {
+		return err
+	}
+	return updateConfigRepos(admin.configPath, func(entry RepoConfig) bool {
+		return resolveConfigPath(configDir, entry.Path) != path
+	}, repoConfig)
+}
+
+// ServeRepos adds or removes a repository.
+//
+// A POST request adds the repository described by the JSON-encoded RepoConfig in its body, and
+// responds with the repository's list item. The path must be absolute, and the slug, if given, must not
+// be used by another repository. If the repository is already served, then its metadata is replaced.
+//
+// A DELETE request removes the repository given by the 'repo' URL parameter.
+func (admin *RepoAdmin) ServeRepos(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		admin.addRepo(w, r)
+	case http.MethodDelete:
+		admin.removeRepo(w, r)
+	default:
+		w.Header().Set("Allow", "POST, DELETE")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (admin *RepoAdmin) addRepo(w http.ResponseWriter, r *http.Request) {
+	var repoConfig RepoConfig
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAdminRequestBytes)).Decode(&repoConfig); err != nil {
+		http.Error(w, "Invalid repository configuration: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !filepath.IsAbs(repoConfig.Path) {
+		http.Error(w, "The repository path must be absolute", http.StatusBadRequest)
+		return
+	}
+	repoConfig.Path = filepath.Clean(repoConfig.Path)
+	added := false
+	if mirror := repoConfig.Mirror; mirror != nil {
+		if mirror.Upstream == "" {
+			http.Error(w, "The mirror has no upstream", http.StatusBadRequest)
+			return
+		}
+		if err := mirror.checkArgs(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if isLocalPath(mirror.Upstream) && !filepath.IsAbs(mirror.Upstream) {
+			http.Error(w, "The upstream path must be absolute", http.StatusBadRequest)
+			return
+		}
+		if _, err := os.Stat(repoConfig.Path); os.IsNotExist(err) {
+			// A mirror that is created here is removed again if the repository is not added, so
+			// that a failed request leaves nothing behind.
+			defer func() {
+				if !added {
+					os.RemoveAll(repoConfig.Path)
+				}
+			}()
+		}
+		if err := InitMirror(repoConfig.Path); err != nil {
+			http.Error(w, "Unable to create the mirror: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	repo, err := admin.newRepo(repoConfig.Path)
+	if err != nil {
+		http.Error(w, "Unable to open the repository: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if slug := slugify(repoConfig.Slug); slug != "" {
+		if other, ok := admin.cache.lookup(slug); ok && !other.isGone() && other.Repo.GetPath() != repo.GetPath() {
+			http.Error(w, fmt.Sprintf("The slug %q is already used by %q", slug, other.Repo.GetPath()), http.StatusConflict)
+			return
+		}
+	}
+	// The change is only made once it is persisted, so that a failure leaves nothing half done.
+	admin.configMu.Lock()
+	defer admin.configMu.Unlock()
+	if err := admin.persist(r, repo.GetPath(), &repoConfig); err != nil {
+		http.Error(w, "Unable to persist the repository: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	added = true
+	existing, ok := admin.cache.lookup(getRepoID(repo))
+	created := !ok || existing.isGone()
+	repoDetails := admin.cache.AddConfiguredRepo(repo, repoConfig)
+	log.Printf("Added the repository %q as %q", repo.GetPath(), repoDetails.ID())
+	if created {
+		// The repository is read right away, rather than by the first request for it.
+		go repoDetails.refresh()
+		w.WriteHeader(http.StatusCreated)
+	}
+	serveJSON(repoDetails.GetListItem(), w)
+}
+
+func (admin *RepoAdmin) removeRepo(w http.ResponseWriter, r *http.Request) {
+	repoDetails, err := admin.cache.getRepoDetails(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	admin.configMu.Lock()
+	defer admin.configMu.Unlock()
+	if err := admin.persist(r, repoDetails.Repo.GetPath(), nil); err != nil {
+		http.Error(w, "Unable to persist the removal of the repository: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	admin.cache.removeRepo(repoDetails)
+	log.Printf("Removed the repository %q", repoDetails.Repo.GetPath())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ServeReindex reads every review in the repository given by the 'repo' URL parameter again,
+// discarding what was read before, and responds with the repository's status once that is done.
+func (admin *RepoAdmin) ServeReindex(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	repoDetails, err := admin.cache.getRepoDetails(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err := repoDetails.reindex(); err != nil {
+		http.Error(w, "Unable to reindex the repository: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	serveJSON(repoDetails.GetStatus(), w)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAdminAddAndRemoveRepo(t *testing.T) {
+	root := newTestTree(t, []string{"scanned"}, []string{"scanned"})
+	runTestGit(t, filepath.Join(root, "scanned"), "commit", "-q", "--allow-empty", "-m", "Initial commit")
+	dir := newTestGitDir(t)
+	configPath := writeTestConfig(t, `{"roots": [{"path": "`+root+`"}]}`)
+	cache := NewRepoCache()
+	cache.Scan([]ScanRoot{{Path: root}}, 1, NewGitRepo)
+	admin := NewRepoAdmin(cache, NewGitRepo, configPath)
+
+	body := `{"path": "` + dir + `", "slug": "added", "name": "Added"}`
+	w := httptest.NewRecorder()
+	admin.ServeRepos(w, httptest.NewRequest("POST", "/admin/repos?persist=true", strings.NewReader(body)))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Unexpected response for adding a repository: %d %q", w.Code, w.Body.String())
+	}
+	var item RepoListItem
+	if err := json.Unmarshal(w.Body.Bytes(), &item); err != nil || item.ID != "added" || item.Name != "Added" {
+		t.Fatalf("Unexpected repository: %+v, %v", item, err)
+	}
+	if _, err := cache.getRepoDetails(httptest.NewRequest("GET", "/api/repo_summary?repo=added", nil)); err != nil {
+		t.Fatalf("The added repository is not served: %v", err)
+	}
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Repos) != 1 || config.Repos[0].Path != dir || config.Repos[0].Slug != "added" || len(config.Roots) != 1 {
+		t.Fatalf("Unexpected persisted configuration: %+v", config)
+	}
+
+	// Adding the repository again only replaces its metadata.
+	w = httptest.NewRecorder()
+	admin.ServeRepos(w, httptest.NewRequest("POST", "/admin/repos", strings.NewReader(`{"path": "`+dir+`", "name": "Renamed"}`)))
+	if w.Code != http.StatusOK || cache.Len() != 2 {
+		t.Fatalf("Unexpected response for adding the repository again: %d %q", w.Code, w.Body.String())
+	}
+
+	for _, slug := range []string{"added", "scanned"} {
+		w = httptest.NewRecorder()
+		admin.ServeRepos(w, httptest.NewRequest("DELETE", "/admin/repos?persist=true&repo="+slug, nil))
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("Unexpected response for removing %q: %d %q", slug, w.Code, w.Body.String())
+		}
+	}
+	if cache.Len() != 0 {
+		t.Fatalf("Unexpected repositories after removing them: %d", cache.Len())
+	}
+	config, err = LoadConfig(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Repos) != 0 || len(config.Roots) != 1 {
+		t.Fatalf("Unexpected persisted configuration: %+v", config)
+	}
+	// A removed repository is not added back by scanning for repositories.
+	cache.Scan([]ScanRoot{{Path: root}}, 1, NewGitRepo)
+	if cache.Len() != 0 {
+		t.Fatal("Rescanning added back a removed repository")
+	}
+}
+
+func TestAdminRejectsInvalidRequests(t *testing.T) {
+	cache := NewRepoCache()
+	admin := NewRepoAdmin(cache, NewGitRepo, "")
+	dir := newTestGitDir(t)
+	for _, tc := range []struct {
+		method, url, body string
+		code              int
+	}{
+		{"GET", "/admin/repos", "", http.StatusMethodNotAllowed},
+		{"POST", "/admin/repos", "{", http.StatusBadRequest},
+		{"POST", "/admin/repos", `{"path": "relative"}`, http.StatusBadRequest},
+		{"POST", "/admin/repos", `{"path": "` + filepath.Join(dir, "missing") + `"}`, http.StatusBadRequest},
+		{"POST", "/admin/repos", `{"path": "` + filepath.Join(dir, "mirror") + `", "mirror": {"upstream": "--upload-pack=touch pwned"}}`, http.StatusBadRequest},
+		{"POST", "/admin/repos", `{"path": "` + filepath.Join(dir, "mirror") + `", "mirror": {"upstream": "` + dir + `", "refspecs": ["-x"]}}`, http.StatusBadRequest},
+		{"POST", "/admin/repos?persist=true", `{"path": "` + dir + `"}`, http.StatusInternalServerError},
+		{"DELETE", "/admin/repos?repo=missing", "", http.StatusNotFound},
+	} {
+		w := httptest.NewRecorder()
+		admin.ServeRepos(w, httptest.NewRequest(tc.method, tc.url, strings.NewReader(tc.body)))
+		if w.Code != tc.code {
+			t.Errorf("Unexpected response for %s %s %s: %d %q", tc.method, tc.url, tc.body, w.Code, w.Body.String())
+		}
+	}
+	if cache.Len() != 0 {
+		t.Error("A repository was added even though the change could not be persisted")
+	}
+
+	// A mirror is not left behind when the change cannot be persisted.
+	mirror := filepath.Join(dir, "unpersisted")
+	body := `{"path": "` + mirror + `", "mirror": {"upstream": "` + dir + `"}}`
+	w := httptest.NewRecorder()
+	admin.ServeRepos(w, httptest.NewRequest("POST", "/admin/repos?persist=true", strings.NewReader(body)))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Unexpected response for an unpersisted mirror: %d %q", w.Code, w.Body.String())
+	}
+	if _, err := os.Stat(mirror); !os.IsNotExist(err) {
+		t.Errorf("The unpersisted mirror was left behind: %v", err)
+	}
+}
+
+func TestAdminReindex(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	countingRepo := &notesCountingRepo{gitRepo: repo.(*gitRepo)}
+	cache := NewRepoCache()
+	repoDetails := cache.AddRepo(countingRepo)
+	if err := repoDetails.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	calls := countingRepo.getAllNotesCalls
+
+	admin := NewRepoAdmin(cache, NewGitRepo, "")
+	w := httptest.NewRecorder()
+	admin.ServeReindex(w, httptest.NewRequest("POST", "/admin/reindex?repo="+repoDetails.ID(), nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Unexpected response for reindexing: %d %q", w.Code, w.Body.String())
+	}
+	if countingRepo.getAllNotesCalls <= calls {
+		t.Fatal("Reindexing reused the existing index")
+	}
+	var status RepoStatus
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil || !status.Healthy {
+		t.Fatalf("Unexpected status after reindexing: %+v, %v", status, err)
+	}
+}
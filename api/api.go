@@ -19,72 +19,275 @@ package api
 import (
 	"encoding/json"
 	"errors"
+	"log"
 	"net/http"
 	"sort"
-	"strconv"
+	"sync"
 
 	"github.com/google/git-appraise/repository"
 	"github.com/google/git-appraise/review"
 )
 
 const (
-	// SHA1 produces 160 bit hashes, so a hex-encoded hash should be no more than 40 characters.
-	maxHashLength = 40
+	// SHA1 produces 160 bit hashes, so a hex-encoded SHA1 hash is 40 characters.
+	sha1HashLength = 40
+	// SHA256 produces 256 bit hashes, so a hex-encoded SHA256 hash is 64 characters.
+	sha256HashLength = 64
+	// The length of the longest hash in any object format.
+	maxHashLength = sha256HashLength
 )
 
 // RepoCache encapsulates everything that the API server currently knows about every repository.
-type RepoCache map[string]*RepoDetails
+type RepoCache struct {
+	mu sync.RWMutex
+	// repos holds every repository that has been added to the cache, including the ones that
+	// are gone, keyed by slug.
+	repos map[string]*RepoDetails
+	// aliases maps the legacy ID of every repository to its slug.
+	aliases map[string]string
+	// configured holds the legacy IDs of the repositories that were added from a configuration,
+	// rather than found by a scan.
+	configured map[string]bool
+	// indexed is set once every repository has been read for the first time.
+	indexed bool
+	// store, if set, persists the review index of every repository.
+	store *IndexStore
+	// caches, if set, holds the reviews recently read from every repository.
+	caches *Caches
+	// scan reports the progress of finding the repositories to add to the cache.
+	scan ScanProgress
+	// refresh, if set, describes how newly added repositories are refreshed in the background.
+	refresh *refreshSettings
+	// removed holds the paths of the repositories that were removed through the admin API,
+	// so that scans do not add them back.
+	removed map[string]bool
+}
+
+// NewRepoCache constructs a new, empty RepoCache.
+func NewRepoCache() *RepoCache {
+	return &RepoCache{
+		repos:      make(map[string]*RepoDetails),
+		aliases:    make(map[string]string),
+		configured: make(map[string]bool),
+		removed:    make(map[string]bool),
+	}
+}
+
+// AddRepo adds the given repository to the cache, and returns its details.
+//
+// The repository's slug is derived from the name of its directory. If the repository
+// is already in the cache, then its existing details are returned instead.
+func (cache *RepoCache) AddRepo(repo repository.Repo) *RepoDetails {
+	return cache.addRepo(repo, "", nil)
+}
 
-// AddRepo adds the given repository to the cache.
-func (cache RepoCache) AddRepo(repo repository.Repo) {
+// AddConfiguredRepo adds the given repository, as declared by the given configuration, to the cache.
+//
+// If the repository is already in the cache, then its metadata is replaced. If the configuration
+// declares an upstream, then the repository is kept in sync with it in the background.
+func (cache *RepoCache) AddConfiguredRepo(repo repository.Repo, config RepoConfig) *RepoDetails {
+	repoDetails := cache.addRepo(repo, config.Slug, &config.RepoMetadata)
+	if config.Mirror != nil {
+		repoDetails.startMirroring(config.Mirror)
+	}
+	return repoDetails
+}
+
+// addRepo adds the given repository to the cache under the given slug, or under a slug derived
+// from its directory if that is empty. Repositories with metadata are ones that were configured.
+//
+// If the slug is already taken by another repository, then the legacy ID of one of them is
+// appended to its slug, so that each repository keeps a unique slug. That is the repository
+// being added, unless both repositories were found by scans, in which case the slug goes to
+// the one with the smaller path. That way the slugs do not depend on the order that the
+// repositories happen to be found in.
+func (cache *RepoCache) addRepo(repo repository.Repo, slug string, metadata *RepoMetadata) *RepoDetails {
 	repoDetails := NewRepoDetails(repo)
-	cache[repoDetails.ID] = repoDetails
+	if slug = slugify(slug); slug == "" {
+		slug = repoDetails.ID()
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	delete(cache.removed, repo.GetPath())
+	if metadata != nil {
+		cache.configured[repoDetails.legacyID] = true
+	}
+	if existingSlug, ok := cache.aliases[repoDetails.legacyID]; ok {
+		if existing := cache.repos[existingSlug]; !existing.isGone() {
+			if metadata != nil {
+				existing.setMetadata(*metadata)
+			}
+			return existing
+		}
+	}
+	if existing, ok := cache.repos[slug]; ok {
+		if existing.isGone() {
+			delete(cache.aliases, existing.legacyID)
+		} else if metadata == nil && !cache.configured[existing.legacyID] && repo.GetPath() < existing.Repo.GetPath() {
+			disambiguated := slug + "-" + existing.legacyID
+			log.Printf("The slug %q of %q is also used by %q, so %q is used for %q instead",
+				slug, existing.Repo.GetPath(), repo.GetPath(), disambiguated, existing.Repo.GetPath())
+			existing.setID(disambiguated)
+			cache.repos[disambiguated] = existing
+			cache.aliases[existing.legacyID] = disambiguated
+		} else {
+			disambiguated := slug + "-" + repoDetails.legacyID
+			log.Printf("The slug %q of %q is already used by %q, so %q is used instead",
+				slug, repo.GetPath(), existing.Repo.GetPath(), disambiguated)
+			slug = disambiguated
+		}
+	}
+	repoDetails.setID(slug)
+	if metadata != nil {
+		repoDetails.setMetadata(*metadata)
+	}
+	repoDetails.store = cache.store
+	repoDetails.caches = cache.caches
+	cache.repos[slug] = repoDetails
+	cache.aliases[repoDetails.legacyID] = slug
+	if cache.refresh != nil {
+		go repoDetails.refreshInBackground(cache.refresh.watch, cache.refresh.pollInterval)
+	}
+	return repoDetails
+}
+
+// SetIndexStore makes the cache persist the review index of every repository to the given store.
+//
+// Stored indexes are loaded when a repository is first read, and are validated against
+// its current state before being served. This must be called before any repository is read.
+func (cache *RepoCache) SetIndexStore(store *IndexStore) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.store = store
+	for _, repoDetails := range cache.repos {
+		repoDetails.store = store
+	}
 }
 
-func checkStringLooksLikeHash(s string) error {
-	if len(s) > maxHashLength {
-		return errors.New("Invalid hash parameter")
+// SetCaches makes the cache keep the reviews that it reads from every repository in the given caches.
+//
+// Commit details and diffs are only cached for repositories that were wrapped by the caches.
+func (cache *RepoCache) SetCaches(caches *Caches) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.caches = caches
+	for _, repoDetails := range cache.repos {
+		repoDetails.caches = caches
+	}
+}
+
+// Len returns the number of repositories in the cache, not counting the ones that are gone.
+func (cache *RepoCache) Len() int {
+	return len(cache.list())
+}
+
+// lookup returns the repository with the given slug or legacy ID.
+func (cache *RepoCache) lookup(id string) (*RepoDetails, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	if repoDetails, ok := cache.repos[id]; ok {
+		return repoDetails, true
+	}
+	if slug, ok := cache.aliases[id]; ok {
+		return cache.repos[slug], true
+	}
+	return nil, false
+}
+
+// list returns every repository in the cache that is not gone, ordered by ID.
+func (cache *RepoCache) list() []*RepoDetails {
+	cache.mu.RLock()
+	var repos []*RepoDetails
+	for _, repoDetails := range cache.repos {
+		if !repoDetails.isGone() {
+			repos = append(repos, repoDetails)
+		}
+	}
+	cache.mu.RUnlock()
+	sort.Slice(repos, func(i, j int) bool { return repos[i].ID() < repos[j].ID() })
+	return repos
+}
+
+// Index reads the reviews of every repository in the cache.
+//
+// The cache is reported as ready by the readiness check once the first call to this completes.
+func (cache *RepoCache) Index() {
+	for _, repoDetails := range cache.list() {
+		repoDetails.refresh()
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.indexed = true
+}
+
+// checkStringLooksLikeHash checks that the given string is a hash, or a prefix of one, in
+// a repository whose hashes have the given length.
+func checkStringLooksLikeHash(s string, hashLength int) error {
+	if len(s) > hashLength {
+		return errInvalidHash
 	}
 	for _, c := range s {
 		if ((c < 'a') || (c > 'f')) && ((c < '0') || (c > '9')) {
-			return errors.New("Invalid hash character")
+			return errInvalidHashCharacter
 		}
 	}
 	return nil
 }
 
-func (cache RepoCache) getRepoDetails(r *http.Request) (*RepoDetails, error) {
-	repoParam := r.URL.Query().Get("repo")
-	if repoParam == "" {
-		return nil, errors.New("No repository specified")
-	}
-	if err := checkStringLooksLikeHash(repoParam); err != nil {
+// The errors returned when a request does not name a repository, or names a revision that cannot be a hash.
+var (
+	errNoRepo               = errors.New("No repository specified")
+	errInvalidHash          = errors.New("Invalid hash parameter")
+	errInvalidHashCharacter = errors.New("Invalid hash character")
+)
+
+// The errors returned when a request names a repository or review that does not exist.
+var (
+	errUnknownRepo   = errors.New("Invalid repository specified")
+	errRepoGone      = errors.New("The repository has been removed")
+	errUnknownReview = errors.New("Invalid review specified")
+)
+
+// findRepo returns the repository with the given slug or legacy ID.
+func (cache *RepoCache) findRepo(id string) (*RepoDetails, error) {
+	if err := checkStringLooksLikeSlug(id); err != nil {
 		return nil, err
 	}
-	repoDetails, ok := cache[repoParam]
+	repoDetails, ok := cache.lookup(id)
 	if !ok {
-		return nil, errors.New("Invalid repository specified")
+		return nil, errUnknownRepo
+	}
+	if repoDetails.isGone() {
+		return nil, errRepoGone
 	}
 	return repoDetails, nil
 }
 
-func (cache RepoCache) getReview(r *http.Request) (*review.Review, error) {
+func (cache *RepoCache) getRepoDetails(r *http.Request) (*RepoDetails, error) {
+	repoParam := r.URL.Query().Get("repo")
+	if repoParam == "" {
+		return nil, errNoRepo
+	}
+	return cache.findRepo(repoParam)
+}
+
+func (cache *RepoCache) getReview(r *http.Request) (*RepoDetails, *review.Review, error) {
 	repoDetails, err := cache.getRepoDetails(r)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	reviewParam := r.URL.Query().Get("review")
 	if reviewParam == "" {
-		return nil, errors.New("No review specified")
+		return nil, nil, errors.New("No review specified")
 	}
-	if err := checkStringLooksLikeHash(reviewParam); err != nil {
-		return nil, err
+	if err := repoDetails.checkHash(reviewParam); err != nil {
+		return nil, nil, err
 	}
-	reviewDetails, err := repoDetails.GetReview(reviewParam)
+	reviewDetails, err := repoDetails.GetReview(r.Context(), reviewParam)
 	if err != nil {
-		return nil, errors.New("Invalid review specified")
+		return nil, nil, err
 	}
-	return reviewDetails, nil
+	return repoDetails, reviewDetails, nil
 }
 
 func serveJSON(v interface{}, w http.ResponseWriter) {
@@ -97,19 +300,25 @@ func serveJSON(v interface{}, w http.ResponseWriter) {
 }
 
 // ServeListReposJSON writes the list of repositories to the given writer.
-func (cache RepoCache) ServeListReposJSON(w http.ResponseWriter, r *http.Request) {
+//
+// The order of the list is given by the optional 'sort' URL parameter, which is one of "id" (the
+// default), "name", "activity", "open", or "awaiting".
+func (cache *RepoCache) ServeListReposJSON(w http.ResponseWriter, r *http.Request) {
 	var reposList ReposList
-	for _, repoDetails := range cache {
+	for _, repoDetails := range cache.list() {
 		reposList = append(reposList, repoDetails.GetListItem())
 	}
-	sort.Stable(reposList)
+	if err := sortRepos(reposList, r.URL.Query().Get("sort")); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	serveJSON(reposList, w)
 }
 
 // ServeRepoSummaryJSON writes the summary of a given repository to the given writer.
 //
 // The repository to summarize is given by the 'repo' URL parameter.
-func (cache RepoCache) ServeRepoSummaryJSON(w http.ResponseWriter, r *http.Request) {
+func (cache *RepoCache) ServeRepoSummaryJSON(w http.ResponseWriter, r *http.Request) {
 	repoDetails, err := cache.getRepoDetails(r)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -127,7 +336,7 @@ func (cache RepoCache) ServeRepoSummaryJSON(w http.ResponseWriter, r *http.Reque
 //
 // The repository, file, and commit are given by the 'repo', 'file' and 'commit'
 // URL parameters.
-func (cache RepoCache) ServeRepoContents(w http.ResponseWriter, r *http.Request) {
+func (cache *RepoCache) ServeRepoContents(w http.ResponseWriter, r *http.Request) {
 	repoDetails, err := cache.getRepoDetails(r)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -138,7 +347,7 @@ func (cache RepoCache) ServeRepoContents(w http.ResponseWriter, r *http.Request)
 		http.Error(w, "No commit specified", http.StatusBadRequest)
 		return
 	}
-	if err := checkStringLooksLikeHash(commitParam); err != nil {
+	if err := repoDetails.checkHash(commitParam); err != nil {
 		http.Error(w, "Invalid commit specified", http.StatusBadRequest)
 		return
 	}
@@ -148,46 +357,35 @@ func (cache RepoCache) ServeRepoContents(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	contents, err := repoDetails.Repo.Show(commitParam, fileParam)
+	contents, err := repoWithContext(r.Context(), repoDetails.Repo).Show(commitParam, fileParam)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		serveError(w, checkContext(r.Context(), "reading the file contents", err), http.StatusBadRequest)
 		return
 	}
 	w.Write([]byte(contents))
 }
 
-func getPageToken(r *http.Request) (page int, err error) {
-	pageParam := r.URL.Query().Get("page")
-	if pageParam != "" {
-		page, err = strconv.Atoi(pageParam)
-		if err != nil {
-			return 0, err
-		}
-		if page < 0 {
-			return 0, errors.New("Invalid page token")
-		}
-	}
-	return page, nil
-}
-
 // ServeClosedReviewsJSON writes a page of the closed reviews list for the given repository to the given writer.
 //
 // The repository to list reviews for is given by the 'repo' URL parameter.
-// The page of the review list to output is given by the 'page' URL parameter.
-func (cache RepoCache) ServeClosedReviewsJSON(w http.ResponseWriter, r *http.Request) {
+// The page of the review list to output is given by the 'page' URL parameter, which holds the
+// token returned with the previous page. The 'sort' and 'page_size' URL parameters choose the
+// order of the reviews and the number of reviews in each page, and the reviews can be filtered
+// by the URL parameters read by parseReviewFilter.
+func (cache *RepoCache) ServeClosedReviewsJSON(w http.ResponseWriter, r *http.Request) {
 	repoDetails, err := cache.getRepoDetails(r)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	pageToken, err := getPageToken(r)
+	query, err := parseReviewListQuery(r.URL.Query())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	closedReviews, err := repoDetails.GetClosedReviews(pageToken)
+	closedReviews, err := repoDetails.GetClosedReviews(r.Context(), query)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		serveError(w, err, http.StatusInternalServerError)
 		return
 	}
 	serveJSON(closedReviews, w)
@@ -196,21 +394,24 @@ func (cache RepoCache) ServeClosedReviewsJSON(w http.ResponseWriter, r *http.Req
 // ServeOpenReviewsJSON writes a page of the open reviews list for the given repository to the given writer.
 //
 // The repository to list reviews for is given by the 'repo' URL parameter.
-// The page of the review list to output is given by the 'page' URL parameter.
-func (cache RepoCache) ServeOpenReviewsJSON(w http.ResponseWriter, r *http.Request) {
+// The page of the review list to output is given by the 'page' URL parameter, which holds the
+// token returned with the previous page. The 'sort' and 'page_size' URL parameters choose the
+// order of the reviews and the number of reviews in each page, and the reviews can be filtered
+// by the URL parameters read by parseReviewFilter.
+func (cache *RepoCache) ServeOpenReviewsJSON(w http.ResponseWriter, r *http.Request) {
 	repoDetails, err := cache.getRepoDetails(r)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	pageToken, err := getPageToken(r)
+	query, err := parseReviewListQuery(r.URL.Query())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	openReviews, err := repoDetails.GetOpenReviews(pageToken)
+	openReviews, err := repoDetails.GetOpenReviews(r.Context(), query)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		serveError(w, err, http.StatusInternalServerError)
 		return
 	}
 	serveJSON(openReviews, w)
@@ -220,10 +421,10 @@ func (cache RepoCache) ServeOpenReviewsJSON(w http.ResponseWriter, r *http.Reque
 //
 // The enclosing repository is given by the 'repo' URL parameter.
 // The review to write is given by the 'review' URL parameter.
-func (cache RepoCache) ServeReviewDetailsJSON(w http.ResponseWriter, r *http.Request) {
-	reviewDetails, err := cache.getReview(r)
+func (cache *RepoCache) ServeReviewDetailsJSON(w http.ResponseWriter, r *http.Request) {
+	_, reviewDetails, err := cache.getReview(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		serveError(w, err, http.StatusBadRequest)
 		return
 	}
 	serveJSON(reviewDetails, w)
@@ -233,38 +434,42 @@ func (cache RepoCache) ServeReviewDetailsJSON(w http.ResponseWriter, r *http.Req
 //
 // The enclosing repository is given by the 'repo' URL parameter.
 // The review to write is given by the 'review' URL parameter.
-func (cache RepoCache) ServeReviewDiff(w http.ResponseWriter, r *http.Request) {
-	reviewDetails, err := cache.getReview(r)
+func (cache *RepoCache) ServeReviewDiff(w http.ResponseWriter, r *http.Request) {
+	repoDetails, reviewDetails, err := cache.getReview(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		serveError(w, err, http.StatusBadRequest)
 		return
 	}
 	lhs := r.URL.Query().Get("lhs")
 	rhs := r.URL.Query().Get("rhs")
-	if err := checkStringLooksLikeHash(lhs); err != nil {
+	if err := repoDetails.checkHash(lhs); err != nil {
 		http.Error(w, "Invalid left-hand-side commit specified", http.StatusBadRequest)
 		return
 	}
-	if err := checkStringLooksLikeHash(rhs); err != nil {
+	if err := repoDetails.checkHash(rhs); err != nil {
 		http.Error(w, "Invalid right-hand-side commit specified", http.StatusBadRequest)
 		return
 	}
-	diffSummary, err := NewDiffSummary(reviewDetails, lhs, rhs)
+	diffSummary, err := NewDiffSummary(r.Context(), reviewDetails, lhs, rhs)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		serveError(w, err, http.StatusInternalServerError)
 		return
 	}
 	serveJSON(diffSummary, w)
 }
 
 // ServeEntryPointRedirect writes the main redirect response to the given writer.
-func (cache RepoCache) ServeEntryPointRedirect(w http.ResponseWriter, r *http.Request) {
-	if len(cache) == 1 {
-		for id := range cache {
-			http.Redirect(w, r, "/static/reviews.html#?repo="+id, http.StatusTemporaryRedirect)
-			return
-		}
+//
+// The redirect target is relative to the base path under which the request was received.
+func (cache *RepoCache) ServeEntryPointRedirect(w http.ResponseWriter, r *http.Request) {
+	staticRoot := BasePath(r) + "/static/"
+	// While the first scan for repositories is running, the list of repositories shows its progress.
+	progress := cache.GetScanProgress()
+	initialScan := progress.Scanning && progress.CompletedScans == 0
+	if repos := cache.list(); len(repos) == 1 && !initialScan {
+		http.Redirect(w, r, staticRoot+"reviews.html#?repo="+repos[0].ID(), http.StatusTemporaryRedirect)
+		return
 	}
-	http.Redirect(w, r, "/static/repos.html", http.StatusTemporaryRedirect)
+	http.Redirect(w, r, staticRoot+"repos.html", http.StatusTemporaryRedirect)
 	return
 }
@@ -0,0 +1,90 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestObjectFormats(t *testing.T) {
+	for objectFormat, hashLength := range map[string]int{"sha1": sha1HashLength, "sha256": sha256HashLength} {
+		dir := newTestGitDirWithObjectFormat(t, objectFormat)
+		addTestReview(t, dir)
+		repo, err := NewGitRepo(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cache := NewRepoCache()
+		repoDetails := cache.AddRepo(repo)
+		review := runTestGit(t, dir, "rev-parse", "feature")
+		head := runTestGit(t, dir, "rev-parse", "master")
+		if len(review) != hashLength || repoDetails.getHashLength() != hashLength || !isCommitHash(review) {
+			t.Fatalf("Unexpected hash %q in a %s repository", review, objectFormat)
+		}
+
+		query := "?repo=" + repoDetails.ID() + "&review=" + review
+		for _, tc := range []struct {
+			url     string
+			handler http.HandlerFunc
+			code    int
+		}{
+			{"/api/review_details" + query, cache.ServeReviewDetailsJSON, http.StatusOK},
+			{"/api/review_details" + query[:len(query)-10], cache.ServeReviewDetailsJSON, http.StatusOK},
+			{"/api/review_diff" + query + "&lhs=" + head + "&rhs=" + review, cache.ServeReviewDiff, http.StatusOK},
+			{"/api/repo_contents" + query + "&commit=" + head + "&file=README.md", cache.ServeRepoContents, http.StatusOK},
+			// Hashes that are longer than those of the repository's object format are rejected.
+			{"/api/review_details" + query + "0", cache.ServeReviewDetailsJSON, http.StatusBadRequest},
+			{"/api/review_diff" + query + "&rhs=" + strings.Repeat("0", hashLength+1), cache.ServeReviewDiff, http.StatusBadRequest},
+			{"/api/repo_contents" + query + "&commit=" + head + "0&file=README.md", cache.ServeRepoContents, http.StatusBadRequest},
+		} {
+			w := httptest.NewRecorder()
+			tc.handler(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.code {
+				t.Errorf("Unexpected response for %s in a %s repository: %d %q", tc.url, objectFormat, w.Code, w.Body.String())
+			}
+		}
+	}
+	if err := checkStringLooksLikeHash(strings.Repeat("a", sha256HashLength), sha1HashLength); err == nil {
+		t.Error("A SHA256 hash was accepted in a SHA1 repository")
+	}
+}
+
+func TestHashLengthNotCachedOnError(t *testing.T) {
+	dir := newTestGitDirWithObjectFormat(t, "sha256")
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoDetails := NewRepoDetails(repo)
+	if err := os.Rename(dir, dir+".moved"); err != nil {
+		t.Fatal(err)
+	}
+	hashLength := repoDetails.getHashLength()
+	if err := os.Rename(dir+".moved", dir); err != nil {
+		t.Fatal(err)
+	}
+	if hashLength != sha1HashLength {
+		t.Errorf("Unexpected hash length of a repository that cannot be read: %d", hashLength)
+	}
+	if hashLength := repoDetails.getHashLength(); hashLength != sha256HashLength {
+		t.Errorf("The hash length read while the repository was missing was kept: %d", hashLength)
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/google/git-appraise/repository"
+)
+
+const (
+	// The approximate memory used by a cache entry in addition to its value.
+	cacheEntryOverhead = 128
+)
+
+// CacheStats describes the usage of a single cache.
+type CacheStats struct {
+	Entries  int   `json:"entries"`
+	Bytes    int64 `json:"bytes"`
+	MaxBytes int64 `json:"maxBytes"`
+	Hits     int64 `json:"hits"`
+	Misses   int64 `json:"misses"`
+}
+
+type lruEntry struct {
+	key   string
+	value interface{}
+	size  int64
+}
+
+// lruCache is a cache that is bounded by the approximate memory used by its values.
+//
+// Once the cache is full, the least recently used values are evicted to make room for new ones.
+// It is safe for concurrent use.
+type lruCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// order holds the entries from most to least recently used.
+	order *list.List
+	stats CacheStats
+}
+
+func newLRUCache(maxBytes int64) *lruCache {
+	return &lruCache{
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		stats:   CacheStats{MaxBytes: maxBytes},
+	}
+}
+
+// get returns the value cached under the given key, if there is one.
+func (cache *lruCache) get(key string) (interface{}, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	element, ok := cache.entries[key]
+	if !ok {
+		cache.stats.Misses++
+		return nil, false
+	}
+	cache.stats.Hits++
+	cache.order.MoveToFront(element)
+	return element.Value.(*lruEntry).value, true
+}
+
+// add caches the given value, which uses approximately the given number of bytes, under the given key.
+//
+// Values that are too large to ever fit in the cache are not cached.
+func (cache *lruCache) add(key string, value interface{}, size int64) {
+	size += cacheEntryOverhead
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if size > cache.stats.MaxBytes {
+		return
+	}
+	if element, ok := cache.entries[key]; ok {
+		cache.remove(element)
+	}
+	cache.entries[key] = cache.order.PushFront(&lruEntry{key: key, value: value, size: size})
+	cache.stats.Bytes += size
+	for cache.stats.Bytes > cache.stats.MaxBytes {
+		cache.remove(cache.order.Back())
+	}
+}
+
+func (cache *lruCache) remove(element *list.Element) {
+	entry := cache.order.Remove(element).(*lruEntry)
+	delete(cache.entries, entry.key)
+	cache.stats.Bytes -= entry.size
+}
+
+func (cache *lruCache) getStats() *CacheStats {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	stats := cache.stats
+	stats.Entries = len(cache.entries)
+	return &stats
+}
+
+// encodedSize estimates the memory footprint of the given value by the size of its JSON encoding.
+func encodedSize(v interface{}) int64 {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return 0
+	}
+	return int64(len(encoded))
+}
+
+// Caches holds the bounded caches of the objects that are read from repositories while serving requests.
+//
+// Reviews are cached per repository state, so a cached review is never served once the
+// repository changes. Commit details and diffs are cached by commit hash, which means
+// that they never go stale and can be shared between repositories.
+type Caches struct {
+	reviews *lruCache
+	commits *lruCache
+	diffs   *lruCache
+}
+
+// NewCaches constructs a set of caches with the given approximate memory limits, in bytes.
+func NewCaches(maxReviewBytes, maxCommitBytes, maxDiffBytes int64) *Caches {
+	return &Caches{
+		reviews: newLRUCache(maxReviewBytes),
+		commits: newLRUCache(maxCommitBytes),
+		diffs:   newLRUCache(maxDiffBytes),
+	}
+}
+
+// Stats reports the usage of each cache, keyed by the kind of object it holds.
+func (caches *Caches) Stats() map[string]*CacheStats {
+	return map[string]*CacheStats{
+		"reviews": caches.reviews.getStats(),
+		"commits": caches.commits.getStats(),
+		"diffs":   caches.diffs.getStats(),
+	}
+}
+
+// Wrap returns a Repo that serves commit details and diffs from the caches when it can.
+func (caches *Caches) Wrap(repo repository.Repo) repository.Repo {
+	return &cachingRepo{Repo: repo, caches: caches}
+}
+
+// isCommitHash reports whether the given string is a full commit hash, as opposed to a ref or an abbreviated hash.
+//
+// The caches do not know the object format of the repositories they wrap, so full hashes in either
+// format are accepted. A SHA256 hash abbreviated to the length of a SHA1 hash is still long enough
+// that it always names the same commit.
+func isCommitHash(s string) bool {
+	return (len(s) == sha1HashLength || len(s) == sha256HashLength) && checkStringLooksLikeHash(s, len(s)) == nil
+}
+
+// cachingRepo is a Repo whose commit details and diffs are cached.
+type cachingRepo struct {
+	repository.Repo
+	caches *Caches
+}
+
+// WithContext returns a copy of the repo whose uncached calls run under the given context.
+func (repo *cachingRepo) WithContext(ctx context.Context) repository.Repo {
+	return &cachingRepo{Repo: repoWithContext(ctx, repo.Repo), caches: repo.caches}
+}
+
+// GetCommitDetails returns the details of a commit's metadata.
+func (repo *cachingRepo) GetCommitDetails(ref string) (*repository.CommitDetails, error) {
+	if !isCommitHash(ref) {
+		return repo.Repo.GetCommitDetails(ref)
+	}
+	if cached, ok := repo.caches.commits.get(ref); ok {
+		details := *cached.(*repository.CommitDetails)
+		return &details, nil
+	}
+	details, err := repo.Repo.GetCommitDetails(ref)
+	if err != nil {
+		return nil, err
+	}
+	cached := *details
+	repo.caches.commits.add(ref, &cached, encodedSize(details))
+	return details, nil
+}
+
+// Diff computes the diff between two given commits.
+func (repo *cachingRepo) Diff(left, right string, diffArgs ...string) (string, error) {
+	if !isCommitHash(left) || !isCommitHash(right) {
+		return repo.Repo.Diff(left, right, diffArgs...)
+	}
+	key := strings.Join(append([]string{left, right}, diffArgs...), " ")
+	if cached, ok := repo.caches.diffs.get(key); ok {
+		return cached.(string), nil
+	}
+	diff, err := repo.Repo.Diff(left, right, diffArgs...)
+	if err != nil {
+		return "", err
+	}
+	repo.caches.diffs.add(key, diff, int64(len(diff)))
+	return diff, nil
+}
+
+func (repo *cachingRepo) listRefs() (map[string]string, error) {
+	indexable, ok := repo.Repo.(indexableRepo)
+	if !ok {
+		return nil, errIncrementalUnsupported
+	}
+	return indexable.listRefs()
+}
+
+func (repo *cachingRepo) changedNotes(from, to string) ([]string, error) {
+	indexable, ok := repo.Repo.(indexableRepo)
+	if !ok {
+		return nil, errIncrementalUnsupported
+	}
+	return indexable.changedNotes(from, to)
+}
+
+func (repo *cachingRepo) syncMirror(config *MirrorConfig) error {
+	mirroring, ok := repo.Repo.(mirroringRepo)
+	if !ok {
+		return errMirrorUnsupported
+	}
+	return mirroring.syncMirror(config)
+}
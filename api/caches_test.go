@@ -0,0 +1,113 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/git-appraise/repository"
+)
+
+func TestLRUCache(t *testing.T) {
+	cache := newLRUCache(2 * (cacheEntryOverhead + 10))
+	cache.add("a", "a", 10)
+	cache.add("b", "b", 10)
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("Missing cached value a")
+	}
+	cache.add("c", "c", 10)
+	if _, ok := cache.get("b"); ok {
+		t.Fatal("The least recently used value was not evicted")
+	}
+	if value, ok := cache.get("c"); !ok || value != "c" {
+		t.Fatalf("Unexpected cached value for c: %v", value)
+	}
+	cache.add("d", "d", 1000)
+	if _, ok := cache.get("d"); ok {
+		t.Fatal("A value larger than the cache was cached")
+	}
+	stats := cache.getStats()
+	if stats.Entries != 2 || stats.Bytes != 2*(cacheEntryOverhead+10) || stats.Hits != 2 || stats.Misses != 2 {
+		t.Fatalf("Unexpected cache stats: %+v", stats)
+	}
+}
+
+// objectCountingRepo counts the number of times that commit details and diffs are read.
+type objectCountingRepo struct {
+	repository.Repo
+	commitDetailsCalls int
+	diffCalls          int
+}
+
+func (repo *objectCountingRepo) GetCommitDetails(ref string) (*repository.CommitDetails, error) {
+	repo.commitDetailsCalls++
+	return repo.Repo.GetCommitDetails(ref)
+}
+
+func (repo *objectCountingRepo) Diff(left, right string, diffArgs ...string) (string, error) {
+	repo.diffCalls++
+	return repo.Repo.Diff(left, right, diffArgs...)
+}
+
+func TestCachingRepo(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	gitRepo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	counter := &objectCountingRepo{Repo: gitRepo}
+	caches := NewCaches(1<<20, 1<<20, 1<<20)
+	repo := repoWithContext(context.Background(), caches.Wrap(counter))
+	master := runTestGit(t, dir, "rev-parse", "master")
+	feature := runTestGit(t, dir, "rev-parse", "feature")
+	for i := 0; i < 2; i++ {
+		details, err := repo.GetCommitDetails(feature)
+		if err != nil || details.Summary != "Feature commit" {
+			t.Fatalf("Unexpected commit details: %+v, %v", details, err)
+		}
+		if _, err := repo.Diff(master, feature); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Refs can move, so they are never cached.
+	repo.GetCommitDetails("feature")
+	if counter.commitDetailsCalls != 2 || counter.diffCalls != 1 {
+		t.Fatalf("Unexpected number of reads: %d commit details, %d diffs", counter.commitDetailsCalls, counter.diffCalls)
+	}
+
+	repoDetails := NewRepoDetails(gitRepo)
+	repoDetails.caches = caches
+	for i := 0; i < 2; i++ {
+		reviewDetails, err := repoDetails.GetReview(context.Background(), feature)
+		if err != nil || reviewDetails.Request.Description != "Feature" {
+			t.Fatalf("Unexpected review: %+v, %v", reviewDetails, err)
+		}
+	}
+	stats := caches.Stats()
+	if stats["reviews"].Hits != 1 || stats["reviews"].Misses != 1 || stats["diffs"].Hits != 1 {
+		t.Fatalf("Unexpected cache stats: reviews %+v, diffs %+v", stats["reviews"], stats["diffs"])
+	}
+
+	// Cached reviews are not served once the repository changes.
+	runTestGit(t, dir, "merge", "-q", "--ff-only", "feature")
+	reviewDetails, err := repoDetails.GetReview(context.Background(), feature)
+	if err != nil || !reviewDetails.Submitted {
+		t.Fatalf("Unexpected review after it was submitted: %+v, %v", reviewDetails, err)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/git-appraise/repository"
+)
+
+// countingRepo is a Repo that counts how many times its reviews are listed.
+type countingRepo struct {
+	repository.Repo
+	listCount int32
+}
+
+func (repo *countingRepo) GetRepoStateHash() (string, error) {
+	// Give concurrent callers a chance to overlap.
+	time.Sleep(10 * time.Millisecond)
+	return repo.Repo.GetRepoStateHash()
+}
+
+func (repo *countingRepo) GetAllNotes(notesRef string) (map[string][]repository.Note, error) {
+	atomic.AddInt32(&repo.listCount, 1)
+	return repo.Repo.GetAllNotes(notesRef)
+}
+
+func TestConcurrentUpdatesAreShared(t *testing.T) {
+	repo := &countingRepo{Repo: repository.NewMockRepoForTest()}
+	repoDetails := NewRepoDetails(repo)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := repoDetails.GetSummary(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	// Listing all reviews reads two notes refs.
+	if count := atomic.LoadInt32(&repo.listCount); count != 2 {
+		t.Errorf("Unexpected number of notes reads for concurrent updates: %d", count)
+	}
+}
+
+func TestConcurrentHandlers(t *testing.T) {
+	cache := NewRepoCache()
+	repo := repository.NewMockRepoForTest()
+	cache.AddRepo(repo)
+	repoParam := "?repo=" + getRepoID(repo)
+	reviewParam := repoParam + "&review=" + repository.TestCommitG
+
+	handlers := []struct {
+		handler http.HandlerFunc
+		query   string
+	}{
+		{cache.ServeListReposJSON, ""},
+		{cache.ServeRepoSummaryJSON, repoParam},
+		{cache.ServeOpenReviewsJSON, repoParam},
+		{cache.ServeClosedReviewsJSON, repoParam},
+		{cache.ServeReviewDetailsJSON, reviewParam},
+		{cache.ServeReviewDiff, reviewParam},
+		{cache.ServeReadyz, ""},
+		{cache.ServeDebugJSON, ""},
+		{cache.ServeEntryPointRedirect, ""},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cache.Index()
+	}()
+	for i := 0; i < 10; i++ {
+		for _, h := range handlers {
+			wg.Add(1)
+			go func(handler http.HandlerFunc, query string) {
+				defer wg.Done()
+				w := httptest.NewRecorder()
+				handler(w, httptest.NewRequest("GET", "/"+query, nil))
+				if w.Code == http.StatusInternalServerError {
+					t.Errorf("Unexpected failure for %q: %d: %s", query, w.Code, w.Body.String())
+				}
+			}(h.handler, h.query)
+		}
+	}
+	wg.Wait()
+}
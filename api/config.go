@@ -0,0 +1,249 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/git-appraise-web/api/types"
+)
+
+// Duration is a length of time that is written in JSON as a string such as "90s" or "5m".
+type Duration time.Duration
+
+// MarshalJSON writes the duration as a string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON parses the duration from a string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// RepoMetadata describes a repository to the people browsing it.
+type RepoMetadata = types.RepoMetadata
+
+// RepoConfig declares a single repository to serve.
+type RepoConfig struct {
+	Path string `json:"path"`
+	// Slug identifies the repository in URLs. This defaults to the name of its directory.
+	Slug string `json:"slug,omitempty"`
+	RepoMetadata
+	// Mirror, if set, keeps the repository in sync with an upstream repository.
+	Mirror *MirrorConfig `json:"mirror,omitempty"`
+}
+
+// Config declares the repositories that the server serves.
+//
+// Repositories can be listed explicitly, found by scanning directories, or both. If a
+// repository is listed explicitly and also found by a scan, then its listed metadata is used.
+type Config struct {
+	Repos []RepoConfig `json:"repos,omitempty"`
+	Roots []ScanRoot   `json:"roots,omitempty"`
+}
+
+// LoadConfig reads the configuration in the JSON file with the given path.
+//
+// Relative paths in the configuration are resolved against the directory containing the file.
+// It is an error for two repositories to be given the same slug.
+func LoadConfig(path string) (*Config, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config Config
+	if err := json.Unmarshal(contents, &config); err != nil {
+		return nil, fmt.Errorf("Invalid configuration in %q: %v", path, err)
+	}
+	configDir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	resolve := func(p string) string {
+		return resolveConfigPath(configDir, p)
+	}
+	slugs := make(map[string]string)
+	for i, repo := range config.Repos {
+		if repo.Path == "" {
+			return nil, fmt.Errorf("Repository %d in %q has no path", i, path)
+		}
+		if slug := slugify(repo.Slug); slug != "" {
+			if other, ok := slugs[slug]; ok {
+				return nil, fmt.Errorf("The repositories %q and %q in %q have the same slug %q", other, repo.Path, path, slug)
+			}
+			slugs[slug] = repo.Path
+		}
+		config.Repos[i].Path = resolve(repo.Path)
+		if mirror := repo.Mirror; mirror != nil {
+			if mirror.Upstream == "" {
+				return nil, fmt.Errorf("The mirror of %q in %q has no upstream", repo.Path, path)
+			}
+			if err := mirror.checkArgs(); err != nil {
+				return nil, fmt.Errorf("The mirror of %q in %q is invalid: %v", repo.Path, path, err)
+			}
+			if isLocalPath(mirror.Upstream) {
+				mirror.Upstream = resolve(mirror.Upstream)
+			}
+		}
+	}
+	for i, root := range config.Roots {
+		if root.Path == "" {
+			return nil, fmt.Errorf("Root %d in %q has no path", i, path)
+		}
+		for _, pattern := range append(root.Include, root.Exclude...) {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("Invalid pattern %q in %q: %v", pattern, path, err)
+			}
+		}
+		config.Roots[i].Path = resolve(root.Path)
+	}
+	return &config, nil
+}
+
+// resolveConfigPath resolves the given path from a configuration file against the directory containing the file.
+func resolveConfigPath(configDir, p string) string {
+	if filepath.IsAbs(p) {
+		return filepath.Clean(p)
+	}
+	return filepath.Join(configDir, p)
+}
+
+// editConfigRepos returns a copy of the given configuration file contents in which the list of
+// repositories only has the entries for which keep returns true, followed by the given entry if
+// it is not nil. The boolean return value reports whether anything changed.
+//
+// Fields that this version of the server does not know about are kept, both in the configuration
+// and in its entries. The edited configuration is re-indented, with its fields sorted by name. Like
+// LoadConfig, this reads the last of any fields that are repeated, and the others are dropped.
+func editConfigRepos(contents []byte, keep func(repo RepoConfig) bool, add *RepoConfig) ([]byte, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(contents, &fields); err != nil {
+		return nil, false, err
+	}
+	if fields == nil {
+		return nil, false, errors.New("The configuration is not a JSON object")
+	}
+	var entries []json.RawMessage
+	if list, ok := fields["repos"]; ok {
+		if err := json.Unmarshal(list, &entries); err != nil {
+			return nil, false, fmt.Errorf("The repositories in the configuration are not a JSON array: %v", err)
+		}
+	}
+	kept := []json.RawMessage{}
+	for _, entry := range entries {
+		var repo RepoConfig
+		if err := json.Unmarshal(entry, &repo); err != nil {
+			return nil, false, err
+		}
+		if keep(repo) {
+			kept = append(kept, entry)
+		}
+	}
+	if add == nil && len(kept) == len(entries) {
+		return contents, false, nil
+	}
+	if add != nil {
+		entry, err := json.Marshal(add)
+		if err != nil {
+			return nil, false, err
+		}
+		kept = append(kept, entry)
+	}
+	list, err := json.Marshal(kept)
+	if err != nil {
+		return nil, false, err
+	}
+	fields["repos"] = list
+	edited, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		return nil, false, err
+	}
+	return append(edited, '\n'), true, nil
+}
+
+// updateConfigRepos changes the list of repositories in the JSON configuration file with the
+// given path, as editConfigRepos does.
+//
+// The entries are passed to keep exactly as they are written in the file, without resolving their
+// relative paths. The new file replaces the old one atomically.
+func updateConfigRepos(path string, keep func(repo RepoConfig) bool, add *RepoConfig) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var config Config
+	if err := json.Unmarshal(contents, &config); err != nil {
+		return fmt.Errorf("Invalid configuration in %q: %v", path, err)
+	}
+	contents, changed, err := editConfigRepos(contents, keep, add)
+	if err != nil {
+		return fmt.Errorf("Unable to edit the configuration in %q: %v", path, err)
+	}
+	if !changed {
+		return nil
+	}
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+"-")
+	if err != nil {
+		return err
+	}
+	if _, err := tempFile.Write(contents); err != nil {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+	if info, err := os.Stat(path); err == nil {
+		os.Chmod(tempFile.Name(), info.Mode())
+	}
+	if err := os.Rename(tempFile.Name(), path); err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+	return nil
+}
+
+// isLocalPath reports whether the given upstream is a path on the local filesystem, rather than a URL.
+//
+// Like git, this treats "host:path" as a URL unless there is a slash before the colon.
+func isLocalPath(upstream string) bool {
+	if strings.Contains(upstream, "://") {
+		return false
+	}
+	colon := strings.Index(upstream, ":")
+	slash := strings.Index(upstream, "/")
+	return colon < 0 || (slash >= 0 && slash < colon)
+}
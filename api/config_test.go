@@ -0,0 +1,192 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestConfig(t *testing.T, contents string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "git-appraise-web-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	configPath := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(configPath, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return configPath
+}
+
+func TestLoadConfig(t *testing.T) {
+	configPath := writeTestConfig(t, `{
+		"repos": [{"path": "/srv/git/website", "name": "Website", "description": "Our website", "tags": ["web"]}],
+		"roots": [{"path": "checkouts", "exclude": ["node_modules"], "maxDepth": 2}]
+	}`)
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Repos) != 1 || config.Repos[0].Path != "/srv/git/website" || config.Repos[0].Name != "Website" ||
+		config.Repos[0].Description != "Our website" || len(config.Repos[0].Tags) != 1 {
+		t.Fatalf("Unexpected repositories: %+v", config.Repos)
+	}
+	if len(config.Roots) != 1 || config.Roots[0].Path != filepath.Join(filepath.Dir(configPath), "checkouts") ||
+		config.Roots[0].MaxDepth != 2 || len(config.Roots[0].Exclude) != 1 {
+		t.Fatalf("Unexpected roots: %+v", config.Roots)
+	}
+
+	for _, invalid := range []string{
+		`{"repos": [{"name": "No path"}]}`,
+		`{"roots": [{"path": "/", "include": ["["]}]}`,
+		`{"roots": "/"}`,
+		`{"repos": [{"path": "/srv/git/mirror", "mirror": {}}]}`,
+		`{"repos": [{"path": "/srv/git/mirror", "mirror": {"upstream": "/srv/upstream", "interval": "soon"}}]}`,
+		`{"repos": [{"path": "/srv/git/a", "slug": "app"}, {"path": "/srv/git/b", "slug": ".app"}]}`,
+	} {
+		if _, err := LoadConfig(writeTestConfig(t, invalid)); err == nil {
+			t.Errorf("Invalid configuration %s was accepted", invalid)
+		}
+	}
+}
+
+func TestLoadMirrorConfig(t *testing.T) {
+	configPath := writeTestConfig(t, `{
+		"repos": [
+			{"path": "local", "mirror": {"upstream": "../upstream", "interval": "5m", "refspecs": ["+refs/heads/master:refs/heads/master"]}},
+			{"path": "remote", "mirror": {"upstream": "git@example.com:project.git"}},
+			{"path": "url", "mirror": {"upstream": "https://example.com/project.git"}}
+		]
+	}`)
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := config.Repos[0].Mirror
+	if local.Upstream != filepath.Join(filepath.Dir(filepath.Dir(configPath)), "upstream") ||
+		local.interval() != 5*time.Minute || len(local.refspecs(true)) != 1 {
+		t.Fatalf("Unexpected local mirror: %+v", local)
+	}
+	remote := config.Repos[1].Mirror
+	if remote.Upstream != "git@example.com:project.git" || remote.interval() != defaultMirrorInterval ||
+		len(remote.refspecs(true)) != len(defaultMirrorRefspecs) {
+		t.Fatalf("Unexpected remote mirror: %+v", remote)
+	}
+	if url := config.Repos[2].Mirror.Upstream; url != "https://example.com/project.git" {
+		t.Fatalf("Unexpected upstream URL: %q", url)
+	}
+}
+
+func TestLoadConfigRejectsOptionLikeMirrors(t *testing.T) {
+	for _, mirror := range []string{
+		`{"upstream": "--upload-pack=touch pwned"}`,
+		`{"upstream": "../upstream", "refspecs": ["--upload-pack=touch pwned"]}`,
+	} {
+		configPath := writeTestConfig(t, `{"repos": [{"path": "local", "mirror": `+mirror+`}]}`)
+		if _, err := LoadConfig(configPath); err == nil {
+			t.Errorf("Loaded the mirror %s", mirror)
+		}
+	}
+}
+
+func TestRepoMetadata(t *testing.T) {
+	cache := NewRepoCache()
+	dir := newTestGitDir(t)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoDetails := cache.AddRepo(repo)
+	if item := repoDetails.GetListItem(); item.Name != filepath.Base(dir) || item.Description != "" {
+		t.Fatalf("Unexpected default metadata: %+v", item)
+	}
+	cache.AddConfiguredRepo(repo, RepoConfig{
+		Path:         dir,
+		RepoMetadata: RepoMetadata{Name: "Test", Description: "A test repo", Tags: []string{"test"}},
+	})
+	summary, err := repoDetails.GetSummary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Name != "Test" || summary.Description != "A test repo" || len(summary.Tags) != 1 {
+		t.Fatalf("Unexpected metadata: %+v", summary)
+	}
+}
+
+func TestEditConfigRepos(t *testing.T) {
+	keepAll := func(RepoConfig) bool { return true }
+	dropOld := func(repo RepoConfig) bool { return repo.Path != "old" }
+	added := &RepoConfig{Path: "/srv/git/new", Slug: "new"}
+	for _, tc := range []struct {
+		contents string
+		keep     func(RepoConfig) bool
+		add      *RepoConfig
+		expected string
+	}{
+		{
+			// Fields that are not known are kept.
+			`{"repos": [{"path": "old", "future": true}, {"path": "other"}], "unknown": {"a": 1}}`,
+			keepAll, added,
+			"{\n  \"repos\": [\n    {\n      \"path\": \"old\",\n      \"future\": true\n    },\n    {\n      \"path\": \"other\"\n    },\n" +
+				"    {\n      \"path\": \"/srv/git/new\",\n      \"slug\": \"new\"\n    }\n  ],\n  \"unknown\": {\n    \"a\": 1\n  }\n}\n",
+		},
+		{
+			`{"repos": [{"path": "old"}], "roots": []}`,
+			dropOld, nil,
+			"{\n  \"repos\": [],\n  \"roots\": []\n}\n",
+		},
+		{
+			`{"roots": [{"path": "/srv"}]}`,
+			keepAll, added,
+			"{\n  \"repos\": [\n    {\n      \"path\": \"/srv/git/new\",\n      \"slug\": \"new\"\n    }\n  ],\n  \"roots\": [\n    {\n      \"path\": \"/srv\"\n    }\n  ]\n}\n",
+		},
+		{
+			`{"repos": null}`,
+			keepAll, added,
+			"{\n  \"repos\": [\n    {\n      \"path\": \"/srv/git/new\",\n      \"slug\": \"new\"\n    }\n  ]\n}\n",
+		},
+		{
+			// Repeated fields are read the same way as LoadConfig reads them.
+			`{"repos": [{"path": "ignored"}], "repos": [{"path": "old"}, {"path": "other"}]}`,
+			dropOld, nil,
+			"{\n  \"repos\": [\n    {\n      \"path\": \"other\"\n    }\n  ]\n}\n",
+		},
+	} {
+		edited, changed, err := editConfigRepos([]byte(tc.contents), tc.keep, tc.add)
+		if err != nil {
+			t.Errorf("Unable to edit %q: %v", tc.contents, err)
+		} else if !changed || string(edited) != tc.expected {
+			t.Errorf("Unexpected edit of %q: %v, %q", tc.contents, changed, edited)
+		}
+	}
+
+	unchanged := `{"repos": [{"path": "other"}]}`
+	if _, changed, err := editConfigRepos([]byte(unchanged), dropOld, nil); err != nil || changed {
+		t.Errorf("Unexpected edit of %q: %v, %v", unchanged, changed, err)
+	}
+	for _, invalid := range []string{`[]`, `null`, `{"repos": {}}`} {
+		if _, _, err := editConfigRepos([]byte(invalid), keepAll, added); err == nil {
+			t.Errorf("Invalid configuration %s was edited", invalid)
+		}
+	}
+}
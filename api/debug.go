@@ -0,0 +1,99 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/git-appraise/review"
+)
+
+// RepoDebugInfo describes the in-memory state that the server holds for a single repository.
+type RepoDebugInfo struct {
+	ID                string     `json:"id"`
+	Path              string     `json:"path"`
+	RepoState         string     `json:"repoState"`
+	OpenReviewCount   int        `json:"openReviewCount"`
+	ClosedReviewCount int        `json:"closedReviewCount"`
+	LastRefresh       *time.Time `json:"lastRefresh,omitempty"`
+	LastError         string     `json:"lastError,omitempty"`
+	// ApproximateBytes estimates the memory used by the repository's review summaries.
+	ApproximateBytes int `json:"approximateBytes"`
+}
+
+// CacheDebugInfo describes the in-memory state of the entire RepoCache.
+type CacheDebugInfo struct {
+	Repos                 []*RepoDebugInfo `json:"repos"`
+	TotalApproximateBytes int              `json:"totalApproximateBytes"`
+	// Caches reports the hit rates and memory usage of the caches of objects read from repositories.
+	Caches map[string]*CacheStats `json:"caches,omitempty"`
+}
+
+// approximateSize estimates the memory footprint of the given review summaries.
+//
+// The estimate is the size of the summaries' JSON encoding, which is dominated by the
+// same strings that dominate their in-memory representation.
+func approximateSize(reviews []review.Summary) int {
+	var size int
+	for _, summary := range reviews {
+		size += int(encodedSize(summary))
+	}
+	return size
+}
+
+// GetDebugInfo describes the in-memory state that the server holds for the repository.
+func (details *RepoDetails) GetDebugInfo() *RepoDebugInfo {
+	status := details.GetStatus()
+	info := &RepoDebugInfo{
+		ID:          status.ID,
+		Path:        status.Path,
+		LastRefresh: status.LastRefresh,
+		LastError:   status.Error,
+	}
+	details.mu.Lock()
+	index := details.index
+	details.mu.Unlock()
+	if index != nil {
+		info.RepoState = index.repoState
+		info.OpenReviewCount = index.openReviewCount
+		info.ClosedReviewCount = index.closedReviewCount
+		info.ApproximateBytes = approximateSize(index.openReviews) + approximateSize(index.closedReviews)
+	}
+	return info
+}
+
+// ServeDebugJSON writes the in-memory state of every repository to the given writer.
+//
+// This is intended for diagnosing the server, and should only be served to administrators.
+func (cache *RepoCache) ServeDebugJSON(w http.ResponseWriter, r *http.Request) {
+	info := &CacheDebugInfo{
+		Repos: []*RepoDebugInfo{},
+	}
+	for _, repoDetails := range cache.list() {
+		repoInfo := repoDetails.GetDebugInfo()
+		info.Repos = append(info.Repos, repoInfo)
+		info.TotalApproximateBytes += repoInfo.ApproximateBytes
+	}
+	cache.mu.RLock()
+	caches := cache.caches
+	cache.mu.RUnlock()
+	if caches != nil {
+		info.Caches = caches.Stats()
+	}
+	serveJSON(info, w)
+}
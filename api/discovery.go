@@ -0,0 +1,230 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/git-appraise-web/api/types"
+	"github.com/google/git-appraise/repository"
+)
+
+// ScanProgress reports the progress of discovering the repositories to serve.
+type ScanProgress = types.ScanProgress
+
+// RepoFactory constructs the Repo for the repository at the given path, and returns
+// an error if the path is not in a repository.
+type RepoFactory func(path string) (repository.Repo, error)
+
+// ScanRoot describes a directory to scan for repositories.
+//
+// Include and Exclude hold glob patterns that are matched against the path of each
+// directory relative to the root, using "/" as the separator. Patterns without a
+// "/" are also matched against the directory's name alone.
+type ScanRoot struct {
+	Path string `json:"path"`
+	// Include, if set, restricts the repositories found under the root to those that match one of its patterns.
+	Include []string `json:"include,omitempty"`
+	// Exclude lists the directories to skip, along with everything beneath them.
+	Exclude []string `json:"exclude,omitempty"`
+	// MaxDepth limits how many levels of directories beneath the root are scanned. Zero means no limit.
+	MaxDepth int `json:"maxDepth,omitempty"`
+}
+
+func matchesAny(patterns []string, relPath string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, relPath); matched {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if matched, _ := filepath.Match(pattern, path.Base(relPath)); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scanTask is a single directory to scan.
+type scanTask struct {
+	root  *ScanRoot
+	path  string
+	depth int
+}
+
+func (cache *RepoCache) startScan() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	startTime := time.Now()
+	cache.scan = ScanProgress{
+		Scanning:       true,
+		CompletedScans: cache.scan.CompletedScans,
+		StartTime:      &startTime,
+	}
+}
+
+func (cache *RepoCache) finishScan() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	endTime := time.Now()
+	cache.scan.Scanning = false
+	cache.scan.CompletedScans++
+	cache.scan.EndTime = &endTime
+}
+
+// scanDir adds the repository in the given directory to the cache if there is one, and
+// otherwise returns the subdirectories that remain to be scanned.
+//
+// The legacy IDs of the repositories that are found are recorded in the given map, which is guarded by the cache's lock.
+func (cache *RepoCache) scanDir(task scanTask, newRepo RepoFactory, found map[string]bool) []scanTask {
+	relPath, err := filepath.Rel(task.root.Path, task.path)
+	if err != nil {
+		return nil
+	}
+	relPath = filepath.ToSlash(relPath)
+	repo, err := newRepo(task.path)
+	isRepo := err == nil
+	var repoDetails *RepoDetails
+	if isRepo && !cache.isRemoved(repo.GetPath()) &&
+		(task.depth == 0 || len(task.root.Include) == 0 || matchesAny(task.root.Include, relPath)) {
+		// Repositories are identified by their path relative to the root, which is unique within it.
+		slug := relPath
+		if task.depth == 0 {
+			slug = filepath.Base(task.root.Path)
+		}
+		repoDetails = cache.addRepo(repo, slug, nil)
+	}
+	cache.mu.Lock()
+	cache.scan.DirectoriesScanned++
+	if repoDetails != nil {
+		// Worktrees of the same repository are found as the same repository, so they are only counted once.
+		found[repoDetails.legacyID] = true
+		cache.scan.ReposFound = len(found)
+	}
+	cache.mu.Unlock()
+	if isRepo || (task.root.MaxDepth > 0 && task.depth >= task.root.MaxDepth) {
+		return nil
+	}
+
+	entries, err := ioutil.ReadDir(task.path)
+	if err != nil {
+		log.Printf("Unable to scan %q for repositories: %v", task.path, err)
+		return nil
+	}
+	var subdirs []scanTask
+	for _, entry := range entries {
+		// Git's internal directories are never scanned, even when their repository could not be opened.
+		if !entry.IsDir() || entry.Name() == ".git" {
+			continue
+		}
+		if matchesAny(task.root.Exclude, path.Join(relPath, entry.Name())) {
+			continue
+		}
+		subdirs = append(subdirs, scanTask{
+			root:  task.root,
+			path:  filepath.Join(task.path, entry.Name()),
+			depth: task.depth + 1,
+		})
+	}
+	return subdirs
+}
+
+// Scan finds the repositories under the given root directories, and adds each one to the
+// cache as soon as it is found.
+//
+// Directories are scanned by the given number of workers in parallel, and the
+// directories inside of a repository are not scanned. This returns once the scan is done.
+//
+// Scanning again picks up any repositories that were created since the last scan, and
+// marks the repositories that no longer exist as gone.
+func (cache *RepoCache) Scan(roots []ScanRoot, workers int, newRepo RepoFactory) {
+	if workers < 1 {
+		workers = 1
+	}
+	cache.startScan()
+	defer cache.finishScan()
+
+	found := make(map[string]bool)
+	tasks := make(chan scanTask, workers)
+	var pending sync.WaitGroup
+	var visit func(task scanTask)
+	visit = func(task scanTask) {
+		for _, subdir := range cache.scanDir(task, newRepo, found) {
+			pending.Add(1)
+			select {
+			case tasks <- subdir:
+			default:
+				// Every worker is busy, so this one scans the directory itself rather than waiting.
+				visit(subdir)
+				pending.Done()
+			}
+		}
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for task := range tasks {
+				visit(task)
+				pending.Done()
+			}
+		}()
+	}
+	for i := range roots {
+		pending.Add(1)
+		go func(root *ScanRoot) {
+			tasks <- scanTask{root: root, path: root.Path}
+		}(&roots[i])
+	}
+	pending.Wait()
+	close(tasks)
+	cache.removeMissing(found, newRepo)
+}
+
+// removeMissing marks the repositories that were not found by a scan as gone, unless they still exist.
+//
+// Repositories that were added to the cache from outside of the scanned directory still exist, so they are kept.
+func (cache *RepoCache) removeMissing(found map[string]bool, newRepo RepoFactory) {
+	for _, repoDetails := range cache.list() {
+		if found[repoDetails.legacyID] {
+			continue
+		}
+		if _, err := newRepo(repoDetails.Repo.GetPath()); err == nil {
+			continue
+		}
+		log.Printf("The repository %q no longer exists", repoDetails.Repo.GetPath())
+		repoDetails.markGone()
+	}
+}
+
+// GetScanProgress reports the progress of the most recent scan for repositories.
+func (cache *RepoCache) GetScanProgress() *ScanProgress {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	progress := cache.scan
+	return &progress
+}
+
+// ServeScanProgressJSON writes the progress of the scan for repositories to the given writer.
+func (cache *RepoCache) ServeScanProgressJSON(w http.ResponseWriter, r *http.Request) {
+	serveJSON(cache.GetScanProgress(), w)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+// newTestTree creates a temporary directory containing the given subdirectories, and
+// initializes a git repository in each one that is listed in repos.
+func newTestTree(t *testing.T, dirs []string, repos []string) string {
+	t.Helper()
+	root, err := ioutil.TempDir("", "git-appraise-web-tree")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(root) })
+	for _, dir := range dirs {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, repo := range repos {
+		runTestGit(t, filepath.Join(root, repo), "init", "-q")
+	}
+	return root
+}
+
+func TestScan(t *testing.T) {
+	root := newTestTree(t,
+		[]string{"a/nested", "b/c", "d/e/f", "g"},
+		[]string{"a", "b/c", "d/e/f"})
+	cache := NewRepoCache()
+	cache.Scan([]ScanRoot{{Path: root}}, 2, NewGitRepo)
+
+	var paths []string
+	for _, repoDetails := range cache.list() {
+		relPath, err := filepath.Rel(root, repoDetails.Repo.GetPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, relPath)
+	}
+	sort.Strings(paths)
+	if len(paths) != 3 || paths[0] != "a" || paths[1] != "b/c" || paths[2] != "d/e/f" {
+		t.Fatalf("Unexpected repositories found: %v", paths)
+	}
+
+	// The root, "b", "d", "d/e", and "g" are scanned in addition to the repositories,
+	// but the directory inside of "a" is not.
+	progress := cache.GetScanProgress()
+	if progress.Scanning || progress.ReposFound != 3 || progress.DirectoriesScanned != 8 || progress.EndTime == nil {
+		t.Fatalf("Unexpected scan progress: %+v", progress)
+	}
+
+	w := httptest.NewRecorder()
+	cache.ServeScanProgressJSON(w, httptest.NewRequest("GET", "/api/scan_progress", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Unexpected response for the scan progress: %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestRescan(t *testing.T) {
+	root := newTestTree(t, []string{"a", "b", "c"}, []string{"a", "b"})
+	cache := NewRepoCache()
+	cache.Scan([]ScanRoot{{Path: root}}, 2, NewGitRepo)
+	cache.RefreshInBackground(false, time.Hour)
+	repos := cache.list()
+	if len(repos) != 2 {
+		t.Fatalf("Unexpected repositories: %v", repos)
+	}
+	removed, kept := repos[0], repos[1]
+	if filepath.Base(removed.Repo.GetPath()) != "a" {
+		removed, kept = kept, removed
+	}
+
+	if err := os.RemoveAll(filepath.Join(root, "a")); err != nil {
+		t.Fatal(err)
+	}
+	runTestGit(t, filepath.Join(root, "c"), "init", "-q")
+	cache.Scan([]ScanRoot{{Path: root}}, 2, NewGitRepo)
+
+	var names []string
+	for _, repoDetails := range cache.list() {
+		names = append(names, filepath.Base(repoDetails.Repo.GetPath()))
+	}
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != "b" || names[1] != "c" {
+		t.Fatalf("Unexpected repositories after rescanning: %v", names)
+	}
+	if details, ok := cache.lookup(kept.ID()); !ok || details != kept {
+		t.Fatal("Rescanning replaced a repository that still exists")
+	}
+	select {
+	case <-removed.stop:
+	default:
+		t.Fatal("The removed repository is still refreshed in the background")
+	}
+	r := httptest.NewRequest("GET", "/api/repo_summary?repo="+removed.ID(), nil)
+	if _, err := cache.getRepoDetails(r); err == nil {
+		t.Fatal("The removed repository is still served")
+	}
+}
+
+func TestScanFilters(t *testing.T) {
+	root := newTestTree(t,
+		[]string{"team/a", "team/b", "other/c", "vendor/d", "deep/1/2/e"},
+		[]string{"team/a", "team/b", "other/c", "vendor/d", "deep/1/2/e"})
+	cache := NewRepoCache()
+	cache.Scan([]ScanRoot{{
+		Path:     root,
+		Include:  []string{"team/*", "c", "e"},
+		Exclude:  []string{"vendor", "team/b"},
+		MaxDepth: 3,
+	}}, 2, NewGitRepo)
+
+	var names []string
+	for _, repoDetails := range cache.list() {
+		names = append(names, filepath.Base(repoDetails.Repo.GetPath()))
+	}
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != "a" || names[1] != "c" {
+		t.Fatalf("Unexpected repositories: %v", names)
+	}
+}
+
+func TestScanWorktrees(t *testing.T) {
+	root := newTestWorktrees(t)
+	cache := NewRepoCache()
+	cache.Scan([]ScanRoot{{Path: root}}, 2, NewGitRepo)
+	var names []string
+	for _, repoDetails := range cache.list() {
+		names = append(names, filepath.Base(repoDetails.Repo.GetPath()))
+	}
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != "main" || names[1] != "mirror.git" {
+		t.Fatalf("Unexpected repositories: %v", names)
+	}
+	if progress := cache.GetScanProgress(); progress.ReposFound != 2 {
+		t.Fatalf("Unexpected scan progress: %+v", progress)
+	}
+}
@@ -0,0 +1,548 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/git-appraise/repository"
+)
+
+// gitRepo extends the git-appraise GitRepo so that it can be bound to a context.
+//
+// The methods that are used to read repositories are overridden to run their git commands under
+// that context, so that they are killed once the request, or the background work, they serve is
+// done. The methods that change a repository are never used by the server, so they are left to
+// the GitRepo, and listed in unboundGitRepoMethods.
+type gitRepo struct {
+	*repository.GitRepo
+	ctx context.Context
+}
+
+// unboundGitRepoMethods lists the methods of the Repo interface that gitRepo does not run under its
+// context, since they change the repository or read the user's configuration.
+var unboundGitRepoMethods = []string{
+	"ArchiveRef",
+	"AppendNote",
+	"FetchAndReturnNewReviewHashes",
+	"GetCoreEditor",
+	"GetSubmitStrategy",
+	"GetUserEmail",
+	"GetUserSigningKey",
+	"HasUncommittedChanges",
+	"MergeAndSignRef",
+	"MergeArchives",
+	"MergeNotes",
+	"MergeRef",
+	"PullNotes",
+	"PullNotesAndArchive",
+	"PushNotes",
+	"PushNotesAndArchive",
+	"RebaseAndSignRef",
+	"RebaseRef",
+	"SwitchToRef",
+}
+
+// NewGitRepo determines if the given directory is inside of a git repository,
+// and returns the corresponding Repo instance if it is.
+//
+// The returned Repo's path is the canonical path of the repository, as returned by repoRoot,
+// and it runs git commands under the context of the request it is serving.
+func NewGitRepo(path string) (repository.Repo, error) {
+	root, err := repoRoot(path)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := repository.NewGitRepo(root)
+	if err != nil {
+		return nil, err
+	}
+	return &gitRepo{GitRepo: repo, ctx: context.Background()}, nil
+}
+
+// revParse runs "git rev-parse" with the given arguments in the given directory, and returns each line of its output.
+func revParse(dir string, args ...string) ([]string, error) {
+	cmd := exec.Command("git", append([]string{"rev-parse"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != len(args) {
+		return nil, fmt.Errorf("Unexpected output from git rev-parse in %q: %q", dir, out)
+	}
+	return lines, nil
+}
+
+// objectHashLength returns the length of the hex-encoded object hashes in the repository at the given path.
+//
+// Repositories use SHA1 hashes unless they were created with "--object-format=sha256". Versions of git
+// that cannot report a repository's object format predate SHA256, so SHA1 is assumed when it is not reported.
+// If the repository cannot be read, then the length of SHA1 hashes is returned along with the error.
+func objectHashLength(path string) (int, error) {
+	format, err := revParse(path, "--show-object-format")
+	if err != nil {
+		return sha1HashLength, err
+	}
+	if format[0] == "sha256" {
+		return sha256HashLength, nil
+	}
+	return sha1HashLength, nil
+}
+
+// repoRoot returns the canonical path of the repository containing the given directory.
+//
+// That is the top level of a repository's main worktree, or the directory of a bare repository.
+// Linked worktrees share the repository of the worktree, or bare repository, that they were
+// created from, so they resolve to its path rather than their own.
+func repoRoot(path string) (string, error) {
+	info, err := revParse(path, "--is-bare-repository", "--git-common-dir")
+	if err != nil {
+		return "", err
+	}
+	commonDir := info[1]
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(path, commonDir)
+	}
+	commonDir = filepath.Clean(commonDir)
+	if info[0] == "true" {
+		return commonDir, nil
+	}
+	commonInfo, err := revParse(commonDir, "--is-bare-repository")
+	if err != nil {
+		return "", err
+	}
+	if commonInfo[0] == "true" {
+		// This is a worktree linked to a bare repository.
+		return commonDir, nil
+	}
+	if filepath.Base(commonDir) == ".git" {
+		return filepath.Dir(commonDir), nil
+	}
+	// The git directory is separate from the main worktree, so it does not say where that worktree is.
+	topLevel, err := revParse(path, "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return topLevel[0], nil
+}
+
+// WithContext returns a copy of the repo whose git commands run under the given context.
+func (repo *gitRepo) WithContext(ctx context.Context) repository.Repo {
+	return &gitRepo{GitRepo: repo.GitRepo, ctx: ctx}
+}
+
+// Run the given git command with the given I/O reader/writers, returning an error if it fails.
+//
+// If the repo's context is done before the command completes, then the command
+// is killed and a TimeoutError is returned.
+func (repo *gitRepo) runGitCommandWithIO(stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	cmd := exec.CommandContext(repo.ctx, "git", args...)
+	cmd.Dir = repo.Path
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if ctxErr := repo.ctx.Err(); ctxErr != nil {
+		return &TimeoutError{Op: "running git " + args[0], Err: ctxErr}
+	}
+	return err
+}
+
+// Run the given git command and return its stdout and stderr.
+func (repo *gitRepo) runGitCommandRaw(args ...string) (string, string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	err := repo.runGitCommandWithIO(nil, &stdout, &stderr, args...)
+	if _, ok := err.(*TimeoutError); ok {
+		return "", "", err
+	}
+	return strings.TrimSpace(stdout.String()), strings.TrimSpace(stderr.String()), err
+}
+
+// Run the given git command and return its stdout, or an error if the command fails.
+func (repo *gitRepo) runGitCommand(args ...string) (string, error) {
+	stdout, stderr, err := repo.runGitCommandRaw(args...)
+	if _, ok := err.(*exec.ExitError); ok {
+		if stderr == "" {
+			stderr = "Error running git command: " + strings.Join(args, " ")
+		}
+		err = errors.New(stderr)
+	}
+	return stdout, err
+}
+
+// GetRepoStateHash returns a hash which embodies the entire current state of a repository.
+func (repo *gitRepo) GetRepoStateHash() (string, error) {
+	stateSummary, err := repo.runGitCommand("show-ref")
+	return fmt.Sprintf("%x", sha1.Sum([]byte(stateSummary))), err
+}
+
+// VerifyCommit verifies that the supplied hash points to a known commit.
+func (repo *gitRepo) VerifyCommit(hash string) error {
+	objectType, err := repo.runGitCommand("cat-file", "-t", hash)
+	if err != nil {
+		return err
+	}
+	if objectType != "commit" {
+		return fmt.Errorf("Hash %q points to a non-commit object of type %q", hash, objectType)
+	}
+	return nil
+}
+
+// VerifyGitRef verifies that the supplied ref points to a known commit.
+func (repo *gitRepo) VerifyGitRef(ref string) error {
+	_, err := repo.runGitCommand("show-ref", "--verify", ref)
+	return err
+}
+
+// GetHeadRef returns the ref that is the current HEAD.
+func (repo *gitRepo) GetHeadRef() (string, error) {
+	return repo.runGitCommand("symbolic-ref", "HEAD")
+}
+
+// GetCommitHash returns the hash of the commit pointed to by the given ref.
+func (repo *gitRepo) GetCommitHash(ref string) (string, error) {
+	return repo.runGitCommand("show", "-s", "--format=%H", ref)
+}
+
+// ResolveRefCommit returns the commit pointed to by the given ref, which may be a remote ref.
+func (repo *gitRepo) ResolveRefCommit(ref string) (string, error) {
+	if err := repo.VerifyGitRef(ref); err == nil {
+		return repo.GetCommitHash(ref)
+	} else if _, ok := err.(*TimeoutError); ok {
+		return "", err
+	}
+	if strings.HasPrefix(ref, "refs/heads/") {
+		// The ref is a branch. Check if it exists in exactly one remote
+		pattern := strings.Replace(ref, "refs/heads", "**", 1)
+		matchingOutput, err := repo.runGitCommand("for-each-ref", "--format=%(refname)", pattern)
+		if err != nil {
+			return "", err
+		}
+		matchingRefs := strings.Split(matchingOutput, "\n")
+		if len(matchingRefs) == 1 && matchingRefs[0] != "" {
+			return repo.GetCommitHash(matchingRefs[0])
+		}
+		return "", fmt.Errorf("Unable to find a git ref matching the pattern %q", pattern)
+	}
+	return "", fmt.Errorf("Unknown git ref %q", ref)
+}
+
+// GetCommitMessage returns the message stored in the commit pointed to by the given ref.
+func (repo *gitRepo) GetCommitMessage(ref string) (string, error) {
+	return repo.runGitCommand("show", "-s", "--format=%B", ref)
+}
+
+// GetCommitTime returns the commit time of the commit pointed to by the given ref.
+func (repo *gitRepo) GetCommitTime(ref string) (string, error) {
+	return repo.runGitCommand("show", "-s", "--format=%ct", ref)
+}
+
+// GetLastParent returns the last parent of the given commit (as ordered by git).
+func (repo *gitRepo) GetLastParent(ref string) (string, error) {
+	return repo.runGitCommand("rev-list", "--skip", "1", "-n", "1", ref)
+}
+
+// GetCommitDetails returns the details of a commit's metadata.
+func (repo *gitRepo) GetCommitDetails(ref string) (*repository.CommitDetails, error) {
+	var err error
+	show := func(formatString string) (result string) {
+		if err != nil {
+			return ""
+		}
+		result, err = repo.runGitCommand("show", "-s", ref, fmt.Sprintf("--format=tformat:%s", formatString))
+		return result
+	}
+
+	detailsJSON := show("{\"tree\":\"%T\", \"time\": \"%at\"}")
+	if err != nil {
+		return nil, err
+	}
+	var details repository.CommitDetails
+	if err := json.Unmarshal([]byte(detailsJSON), &details); err != nil {
+		return nil, err
+	}
+	details.Author = show("%an")
+	details.AuthorEmail = show("%ae")
+	details.Summary = show("%s")
+	details.Parents = strings.Split(show("%P"), " ")
+	if err != nil {
+		return nil, err
+	}
+	return &details, nil
+}
+
+// MergeBase determines if the first commit that is an ancestor of the two arguments.
+func (repo *gitRepo) MergeBase(a, b string) (string, error) {
+	return repo.runGitCommand("merge-base", a, b)
+}
+
+// IsAncestor determines if the first argument points to a commit that is an ancestor of the second.
+func (repo *gitRepo) IsAncestor(ancestor, descendant string) (bool, error) {
+	_, _, err := repo.runGitCommandRaw("merge-base", "--is-ancestor", ancestor, descendant)
+	if err == nil {
+		return true, nil
+	}
+	if _, ok := err.(*exec.ExitError); ok {
+		return false, nil
+	}
+	return false, err
+}
+
+// Diff computes the diff between two given commits.
+func (repo *gitRepo) Diff(left, right string, diffArgs ...string) (string, error) {
+	args := []string{"diff"}
+	args = append(args, diffArgs...)
+	args = append(args, fmt.Sprintf("%s..%s", left, right))
+	return repo.runGitCommand(args...)
+}
+
+// Show returns the contents of the given file at the given commit.
+func (repo *gitRepo) Show(commit, path string) (string, error) {
+	return repo.runGitCommand("show", fmt.Sprintf("%s:%s", commit, path))
+}
+
+// ListCommits returns the list of commits reachable from the given ref, oldest first.
+func (repo *gitRepo) ListCommits(ref string) []string {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := repo.runGitCommandWithIO(nil, &stdout, &stderr, "rev-list", "--reverse", ref); err != nil {
+		return nil
+	}
+	byteLines := bytes.Split(stdout.Bytes(), []byte("\n"))
+	var commits []string
+	for _, byteLine := range byteLines {
+		commits = append(commits, string(byteLine))
+	}
+	return commits
+}
+
+// ListCommitsBetween returns the list of commits between the two given revisions.
+func (repo *gitRepo) ListCommitsBetween(from, to string) ([]string, error) {
+	out, err := repo.runGitCommand("rev-list", "--reverse", from+".."+to)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+// GetNotes reads the notes from the given ref that annotate the given revision.
+func (repo *gitRepo) GetNotes(notesRef, revision string) []repository.Note {
+	rawNotes, err := repo.runGitCommand("notes", "--ref", notesRef, "show", revision)
+	if err != nil {
+		// We just assume that this means there are no notes
+		return nil
+	}
+	var notes []repository.Note
+	for _, line := range strings.Split(rawNotes, "\n") {
+		notes = append(notes, repository.Note([]byte(line)))
+	}
+	return notes
+}
+
+// notesOverview lists the objects annotated under the given notes ref, and the blobs holding their notes.
+//
+// The returned slices are parallel, so the notes of objectHashes[i] are in notesHashes[i].
+func (repo *gitRepo) notesOverview(notesRef string) (objectHashes, notesHashes []string, err error) {
+	out, err := repo.runGitCommand("notes", "--ref", notesRef, "list")
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		lineParts := strings.Split(line, " ")
+		if len(lineParts) != 2 {
+			return nil, nil, fmt.Errorf("Malformed output line from 'git-notes list': %q", line)
+		}
+		notesHashes = append(notesHashes, lineParts[0])
+		objectHashes = append(objectHashes, lineParts[1])
+	}
+	return objectHashes, notesHashes, nil
+}
+
+// catFileBatch runs "git cat-file" in batch mode with the given format over the given objects.
+func (repo *gitRepo) catFileBatch(format string, objects []string) (*bytes.Buffer, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	stdin := strings.NewReader(strings.Join(objects, "\n") + "\n")
+	if err := repo.runGitCommandWithIO(stdin, &stdout, &stderr, "cat-file", format); err != nil {
+		return nil, err
+	}
+	return &stdout, nil
+}
+
+// commitObjects returns the set of the given objects that are commits.
+//
+// The output of "git cat-file --batch-check" is one "<hash> <type>" line per object.
+func (repo *gitRepo) commitObjects(objects []string) (map[string]bool, error) {
+	out, err := repo.catFileBatch("--batch-check=%(objectname) %(objecttype)", objects)
+	if err != nil {
+		return nil, err
+	}
+	isCommit := make(map[string]bool)
+	for _, line := range strings.Split(out.String(), "\n") {
+		fields := strings.SplitN(line, " ", 2)
+		if len(fields) == 2 && fields[1] == "commit" {
+			isCommit[fields[0]] = true
+		}
+	}
+	return isCommit, nil
+}
+
+// objectContents returns a map from each of the given objects to its contents.
+//
+// The output of "git cat-file --batch" is, for each object, a line with its hash and
+// size, followed by that many bytes of contents and a newline.
+func (repo *gitRepo) objectContents(objects []string) (map[string][]byte, error) {
+	out, err := repo.catFileBatch("--batch=%(objectname) %(objectsize)", objects)
+	if err != nil {
+		return nil, err
+	}
+	contents := make(map[string][]byte)
+	reader := bufio.NewReader(out)
+	for {
+		header, err := reader.ReadString('\n')
+		if err == io.EOF {
+			return contents, nil
+		} else if err != nil {
+			return nil, err
+		}
+		fields := strings.Fields(header)
+		if len(fields) == 2 && fields[1] == "missing" {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("Malformed object header from 'git cat-file': %q", header)
+		}
+		size, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("Malformed object size from 'git cat-file': %q", header)
+		}
+		objectContents := make([]byte, size+1)
+		if _, err := io.ReadFull(reader, objectContents); err != nil {
+			return nil, err
+		}
+		contents[fields[0]] = objectContents[:size]
+	}
+}
+
+// GetAllNotes reads the contents of the notes under the given ref for every commit.
+//
+// The returned value is a mapping from commit hash to the list of notes for that commit.
+// Like the git-appraise implementation, this uses three git commands however many notes
+// there are: one to list the notes, one to filter out the non-commit objects they annotate,
+// and one to read their contents.
+func (repo *gitRepo) GetAllNotes(notesRef string) (map[string][]repository.Note, error) {
+	objectHashes, notesHashes, err := repo.notesOverview(notesRef)
+	if err != nil {
+		return nil, err
+	}
+	commitNotesMap := make(map[string][]repository.Note)
+	if len(objectHashes) == 0 {
+		return commitNotesMap, nil
+	}
+	isCommit, err := repo.commitObjects(objectHashes)
+	if err != nil {
+		return nil, fmt.Errorf("Failure building the set of commit objects: %v", err)
+	}
+	noteContentsMap, err := repo.objectContents(notesHashes)
+	if err != nil {
+		return nil, fmt.Errorf("Failure building the mapping from notes hash to contents: %v", err)
+	}
+	for i, objectHash := range objectHashes {
+		if !isCommit[objectHash] {
+			continue
+		}
+		var notes []repository.Note
+		for _, slice := range bytes.Split(noteContentsMap[notesHashes[i]], []byte("\n")) {
+			notes = append(notes, repository.Note(slice))
+		}
+		commitNotesMap[objectHash] = notes
+	}
+	return commitNotesMap, nil
+}
+
+// ListNotedRevisions returns the collection of revisions that are annotated by notes in the given ref.
+func (repo *gitRepo) ListNotedRevisions(notesRef string) []string {
+	objectHashes, _, err := repo.notesOverview(notesRef)
+	if err != nil || len(objectHashes) == 0 {
+		return nil
+	}
+	// Notes may point to objects that we do not know about (yet), and those are just ignored.
+	isCommit, err := repo.commitObjects(objectHashes)
+	if err != nil {
+		return nil
+	}
+	var revisions []string
+	for _, objectHash := range objectHashes {
+		if isCommit[objectHash] {
+			revisions = append(revisions, objectHash)
+		}
+	}
+	return revisions
+}
+
+// listRefs returns a map from the name of every ref in the repository to the object it points to.
+func (repo *gitRepo) listRefs() (map[string]string, error) {
+	out, err := repo.runGitCommand("for-each-ref", "--format=%(objectname) %(refname)")
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[string]string)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, " ", 2)
+		if len(fields) == 2 {
+			refs[fields[1]] = fields[0]
+		}
+	}
+	return refs, nil
+}
+
+// changedNotes returns the revisions whose notes differ between the two given commits of a notes ref.
+func (repo *gitRepo) changedNotes(from, to string) ([]string, error) {
+	out, err := repo.runGitCommand("diff-tree", "-r", "--name-only", "--no-renames", from, to)
+	if err != nil {
+		return nil, err
+	}
+	var revisions []string
+	for _, path := range strings.Split(out, "\n") {
+		if path == "" {
+			continue
+		}
+		// Notes trees may be fanned out into subdirectories named after a prefix of the revision.
+		revisions = append(revisions, strings.Replace(path, "/", "", -1))
+	}
+	return revisions, nil
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/git-appraise/repository"
+)
+
+// runTestGit runs the given git command in the given directory, failing the test if it fails.
+func runTestGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v failed: %v: %s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// newTestGitDir creates a new git repository with a single commit in a temporary directory.
+func newTestGitDir(t *testing.T) string {
+	t.Helper()
+	return newTestGitDirWithObjectFormat(t, "sha1")
+}
+
+// newTestGitDirWithObjectFormat creates a new git repository with the given object format, and a
+// single commit, in a temporary directory.
+func newTestGitDirWithObjectFormat(t *testing.T, objectFormat string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "git-appraise-web-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	runTestGit(t, dir, "init", "-q", "--object-format="+objectFormat)
+	runTestGit(t, dir, "symbolic-ref", "HEAD", "refs/heads/master")
+	if err := ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("# Test repo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runTestGit(t, dir, "add", "README.md")
+	runTestGit(t, dir, "commit", "-q", "-m", "Initial commit")
+	return dir
+}
+
+// addTestReview creates a branch with a new commit, and requests a review of it.
+func addTestReview(t *testing.T, dir string) {
+	t.Helper()
+	runTestGit(t, dir, "checkout", "-q", "-b", "feature")
+	runTestGit(t, dir, "commit", "-q", "--allow-empty", "-m", "Feature commit")
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/reviews", "add", "-m",
+		`{"timestamp":"1","reviewRef":"refs/heads/feature","targetRef":"refs/heads/master","description":"Feature"}`, "HEAD")
+	runTestGit(t, dir, "checkout", "-q", "master")
+}
+
+func TestGitRepoWithContext(t *testing.T) {
+	dir := newTestGitDir(t)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := runTestGit(t, dir, "rev-parse", "HEAD")
+	contents, err := repo.Show(head, "README.md")
+	if err != nil || contents != "# Test repo" {
+		t.Fatalf("Unexpected file contents: %q, %v", contents, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = repoWithContext(ctx, repo).Show(head, "README.md")
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Err != context.Canceled {
+		t.Fatalf("Unexpected error from a cancelled command: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	serveError(w, err, http.StatusBadRequest)
+	if w.Code != http.StatusGatewayTimeout || !strings.Contains(w.Body.String(), "git show") {
+		t.Fatalf("Unexpected response for a timed out command: %d, %q", w.Code, w.Body.String())
+	}
+}
+
+func TestGitRepoNotes(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	// Notes on objects other than commits are not returned.
+	blob := runTestGit(t, dir, "rev-parse", "HEAD:README.md")
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/reviews", "add", "-m", "not a review", blob)
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/reviews", "append", "-m", "second note", "feature")
+
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upstream, err := repository.NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notes, err := repo.GetAllNotes("refs/notes/devtools/reviews")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedNotes, err := upstream.GetAllNotes("refs/notes/devtools/reviews")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(notes, expectedNotes) {
+		t.Errorf("Unexpected notes: %q, expected %q", notes, expectedNotes)
+	}
+	revisions := repo.ListNotedRevisions("refs/notes/devtools/reviews")
+	if expected := upstream.ListNotedRevisions("refs/notes/devtools/reviews"); !reflect.DeepEqual(revisions, expected) {
+		t.Errorf("Unexpected noted revisions: %q, expected %q", revisions, expected)
+	}
+}
+
+func TestGitRepoMatchesUpstream(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upstream, err := repository.NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	feature := runTestGit(t, dir, "rev-parse", "feature")
+	for _, tc := range []struct {
+		method string
+		args   []interface{}
+	}{
+		{"GetRepoStateHash", nil},
+		{"VerifyCommit", []interface{}{feature}},
+		{"VerifyCommit", []interface{}{"missing"}},
+		{"VerifyGitRef", []interface{}{"refs/heads/feature"}},
+		{"GetHeadRef", nil},
+		{"GetCommitHash", []interface{}{"feature"}},
+		{"GetCommitHash", []interface{}{"missing"}},
+		{"ResolveRefCommit", []interface{}{"refs/heads/feature"}},
+		{"GetCommitMessage", []interface{}{feature}},
+		{"GetCommitTime", []interface{}{feature}},
+		{"GetLastParent", []interface{}{feature}},
+		{"GetCommitDetails", []interface{}{feature}},
+		{"MergeBase", []interface{}{"master", "feature"}},
+		{"IsAncestor", []interface{}{"master", "feature"}},
+		{"IsAncestor", []interface{}{"feature", "master"}},
+		{"Diff", []interface{}{"master", "feature", "--stat"}},
+		{"Show", []interface{}{feature, "README.md"}},
+		{"ListCommits", []interface{}{"feature"}},
+		{"ListCommitsBetween", []interface{}{"master", "feature"}},
+		{"GetNotes", []interface{}{"refs/notes/devtools/reviews", feature}},
+	} {
+		var args []reflect.Value
+		for _, arg := range tc.args {
+			args = append(args, reflect.ValueOf(arg))
+		}
+		results := reflect.ValueOf(repo).MethodByName(tc.method).Call(args)
+		expected := reflect.ValueOf(upstream).MethodByName(tc.method).Call(args)
+		for i := range results {
+			result, expectedResult := results[i].Interface(), expected[i].Interface()
+			if results[i].Type() == reflect.TypeOf((*error)(nil)).Elem() {
+				// The errors carry different messages, so only whether there was one is compared.
+				result, expectedResult = result == nil, expectedResult == nil
+			}
+			if !reflect.DeepEqual(result, expectedResult) {
+				t.Errorf("Unexpected result %d of %s%q: %v, expected %v", i, tc.method, tc.args, result, expectedResult)
+			}
+		}
+	}
+}
+
+// TestGitRepoMethodsBound checks that every method of the Repo interface either runs under
+// gitRepo's context, or is deliberately listed as one that does not.
+func TestGitRepoMethodsBound(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound := map[string]bool{
+		// The path is not read with git.
+		"GetPath": true,
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range parsed.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil {
+				continue
+			}
+			if star, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "gitRepo" {
+					bound[funcDecl.Name.Name] = true
+				}
+			}
+		}
+	}
+	unbound := make(map[string]bool)
+	for _, name := range unboundGitRepoMethods {
+		unbound[name] = true
+		if bound[name] {
+			t.Errorf("The method %s is listed as unbound, but gitRepo overrides it", name)
+		}
+	}
+	repoType := reflect.TypeOf((*repository.Repo)(nil)).Elem()
+	for i := 0; i < repoType.NumMethod(); i++ {
+		name := repoType.Method(i).Name
+		if !bound[name] && !unbound[name] {
+			t.Errorf("The Repo method %s does not run under the context; override it in gitRepo, or list it in unboundGitRepoMethods", name)
+		}
+	}
+}
+
+// newTestWorktrees creates a repository with a linked worktree, and a bare clone of it with a linked
+// worktree of its own, all in the same temporary directory. It returns the path of that directory.
+func newTestWorktrees(t *testing.T) string {
+	t.Helper()
+	root, err := ioutil.TempDir("", "git-appraise-web-worktrees")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(root) })
+	runTestGit(t, root, "init", "-q", "main")
+	runTestGit(t, filepath.Join(root, "main"), "commit", "-q", "--allow-empty", "-m", "Initial commit")
+	runTestGit(t, filepath.Join(root, "main"), "worktree", "add", "-q", filepath.Join(root, "worktree"))
+	runTestGit(t, root, "clone", "-q", "--bare", "main", "mirror.git")
+	runTestGit(t, filepath.Join(root, "mirror.git"), "worktree", "add", "-q", filepath.Join(root, "mirror-worktree"))
+	return root
+}
+
+func TestRepoRoot(t *testing.T) {
+	root := newTestWorktrees(t)
+	if err := os.Mkdir(filepath.Join(root, "main", "subdir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for dir, expected := range map[string]string{
+		"main":               "main",
+		"main/subdir":        "main",
+		"main/.git":          "main",
+		"worktree":           "main",
+		"mirror.git":         "mirror.git",
+		"mirror.git/objects": "mirror.git",
+		"mirror-worktree":    "mirror.git",
+	} {
+		path, err := repoRoot(filepath.Join(root, dir))
+		if err != nil {
+			t.Errorf("Unable to find the repository containing %q: %v", dir, err)
+		} else if path != filepath.Join(root, expected) {
+			t.Errorf("Unexpected repository for %q: %q", dir, path)
+		}
+	}
+	if _, err := repoRoot(root); err == nil {
+		t.Errorf("Found a repository containing %q", root)
+	}
+
+	repo, err := NewGitRepo(filepath.Join(root, "mirror.git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.GetRepoStateHash(); err != nil {
+		t.Fatalf("Unable to read the bare repository: %v", err)
+	}
+}
@@ -0,0 +1,925 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/git-appraise/repository"
+	"github.com/google/git-appraise/review"
+	"github.com/google/git-appraise/review/analyses"
+	"github.com/google/git-appraise/review/ci"
+	"github.com/google/git-appraise/review/comment"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+const (
+	// The maximum nesting of the fields in a GraphQL query, which bounds the work done for a
+	// single request. Comment threads nest, so this also bounds how deep they are read.
+	maxGraphQLDepth = 12
+	// The maximum size of the body of a GraphQL request.
+	maxGraphQLRequestBytes = 1 << 20
+	// The maximum number of aliases in a GraphQL query. Each alias of a field resolves it again,
+	// so aliases would otherwise let a small query do an unbounded amount of work.
+	maxGraphQLAliases = 20
+	// The maximum number of diffs and commit lists, which are the most expensive fields, read by a single query.
+	maxGraphQLDiffs = 10
+	// The maximum page size of the reviews of each repository in the list of repositories,
+	// since a page of reviews is read for every repository.
+	maxListedRepoReviews = 20
+)
+
+// graphQLSchema describes the repositories, reviews, and comments served over GraphQL.
+//
+// Times that come from git-appraise notes are given as they are stored, as the number of seconds
+// since the epoch, while the times computed by the server are given in RFC 3339 format.
+const graphQLSchema = `
+schema {
+	query: Query
+}
+
+type Query {
+	# Lists the repositories, in one of the orders accepted by /api/repos.
+	repos(sort: String): [Repo!]!
+	# Finds a repository by its slug.
+	repo(id: String!): Repo
+}
+
+type Repo {
+	id: String!
+	path: String!
+	name: String!
+	description: String
+	tags: [String!]!
+	branch: String
+	head: String
+	readmeTitle: String
+	latestActivity: String
+	openReviewCount: Int!
+	closedReviewCount: Int!
+	awaitingActionCount: Int!
+	# Lists a page of the open (by default) or closed reviews. The arguments take the same
+	# values as the parameters of /api/v2/repos/{repo}/reviews, with "first" being the page size
+	# and "after" being the nextPageToken of the previous page. For the repositories listed by
+	# "repos", the page size defaults to, and cannot exceed, 20.
+	reviews(
+		status: String, sort: String, first: Int, after: String,
+		requester: String, reviewer: String, target: String, state: String, path: String, description: String,
+		createdAfter: String, createdBefore: String, updatedAfter: String, updatedBefore: String
+	): ReviewPage!
+	review(revision: String!): Review
+	# Finds the review identified by a commit in it, its branch, or its alias or Change-Id.
+	findReview(ref: String!): Review
+	commit(hash: String!): Commit
+}
+
+type ReviewPage {
+	items: [Review!]!
+	nextPageToken: String
+}
+
+type Review {
+	revision: String!
+	requester: String
+	reviewers: [String!]!
+	description: String
+	targetRef: String
+	reviewRef: String
+	baseCommit: String
+	createdAt: String
+	updatedAt: String
+	submitted: Boolean!
+	abandoned: Boolean!
+	# Whether the reviewers accepted (true) or rejected (false) the review, if they voted.
+	resolved: Boolean
+	# One of "accepted", "rejected", or "pending".
+	state: String!
+	threads: [CommentThread!]!
+	# The number of top-level comment threads that are not marked as resolved.
+	unresolvedThreadCount: Int!
+	reports: [Report!]!
+	# The most recent report from a continuous integration system, if any.
+	latestReport: Report
+	analyses: [Analysis!]!
+	headCommit: Commit
+	# A query can read at most 10 lists of commits and diffs in total.
+	commits: [Commit!]!
+	diff(lhs: String, rhs: String): Diff!
+}
+
+type CommentThread {
+	hash: String
+	author: String
+	timestamp: String
+	description: String
+	location: Location
+	resolved: Boolean
+	edited: Boolean!
+	children: [CommentThread!]!
+}
+
+type Location {
+	commit: String
+	path: String
+	startLine: Int
+	endLine: Int
+}
+
+type Report {
+	timestamp: String
+	url: String
+	status: String
+	agent: String
+}
+
+type Analysis {
+	timestamp: String
+	url: String
+	status: String
+}
+
+type Commit {
+	hash: String!
+	author: String
+	authorEmail: String
+	time: String
+	summary: String
+	parents: [String!]!
+}
+
+type Diff {
+	leftHandSide: String!
+	rightHandSide: String!
+	contents: String!
+}
+`
+
+// optionalString converts an empty string to a null GraphQL string.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	return optionalString(t.UTC().Format(time.RFC3339))
+}
+
+type graphQLRoot struct {
+	cache *RepoCache
+}
+
+func (root *graphQLRoot) Repos(args struct{ Sort *string }) ([]*repoResolver, error) {
+	var reposList ReposList
+	for _, repoDetails := range root.cache.list() {
+		reposList = append(reposList, repoDetails.GetListItem())
+	}
+	order := ""
+	if args.Sort != nil {
+		order = *args.Sort
+	}
+	if err := sortRepos(reposList, order); err != nil {
+		return nil, err
+	}
+	repos := []*repoResolver{}
+	for _, item := range reposList {
+		if repoDetails, ok := root.cache.lookup(item.ID); ok {
+			repos = append(repos, &repoResolver{details: repoDetails, listed: true})
+		}
+	}
+	return repos, nil
+}
+
+func (root *graphQLRoot) Repo(args struct{ ID string }) (*repoResolver, error) {
+	repoDetails, err := root.cache.findRepo(args.ID)
+	if err == errUnknownRepo || err == errRepoGone {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &repoResolver{details: repoDetails}, nil
+}
+
+// repoResolver resolves the fields of a repository. Its summary is only read once, however
+// many of its fields are requested.
+type repoResolver struct {
+	details *RepoDetails
+	// listed is set for the repositories in the list of repositories, whose pages of reviews are smaller.
+	listed bool
+
+	once    sync.Once
+	summary *RepoSummary
+	err     error
+}
+
+func (r *repoResolver) getSummary() (*RepoSummary, error) {
+	r.once.Do(func() {
+		r.summary, r.err = r.details.GetSummary()
+	})
+	return r.summary, r.err
+}
+
+func (r *repoResolver) ID() string {
+	return r.details.ID()
+}
+
+func (r *repoResolver) Path() string {
+	return r.details.Repo.GetPath()
+}
+
+func (r *repoResolver) Name() string {
+	return r.details.getMetadata().Name
+}
+
+func (r *repoResolver) Description() *string {
+	return optionalString(r.details.getMetadata().Description)
+}
+
+func (r *repoResolver) Tags() []string {
+	tags := r.details.getMetadata().Tags
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+func (r *repoResolver) Branch() (*string, error) {
+	summary, err := r.getSummary()
+	if err != nil {
+		return nil, err
+	}
+	return optionalString(summary.Branch), nil
+}
+
+func (r *repoResolver) Head() (*string, error) {
+	summary, err := r.getSummary()
+	if err != nil {
+		return nil, err
+	}
+	return optionalString(summary.Head), nil
+}
+
+func (r *repoResolver) ReadmeTitle() (*string, error) {
+	summary, err := r.getSummary()
+	if err != nil {
+		return nil, err
+	}
+	return optionalString(summary.ReadmeTitle), nil
+}
+
+func (r *repoResolver) LatestActivity() (*string, error) {
+	summary, err := r.getSummary()
+	if err != nil || summary.LatestActivity == nil {
+		return nil, err
+	}
+	return optionalTime(*summary.LatestActivity), nil
+}
+
+func (r *repoResolver) OpenReviewCount() (int32, error) {
+	summary, err := r.getSummary()
+	if err != nil {
+		return 0, err
+	}
+	return int32(summary.OpenReviewCount), nil
+}
+
+func (r *repoResolver) ClosedReviewCount() (int32, error) {
+	summary, err := r.getSummary()
+	if err != nil {
+		return 0, err
+	}
+	return int32(summary.ClosedReviewCount), nil
+}
+
+func (r *repoResolver) AwaitingActionCount() (int32, error) {
+	summary, err := r.getSummary()
+	if err != nil {
+		return 0, err
+	}
+	return int32(summary.AwaitingActionCount), nil
+}
+
+type reviewsArgs struct {
+	Status, Sort                                             *string
+	First                                                    *int32
+	After                                                    *string
+	Requester, Reviewer, Target, State, Path, Description    *string
+	CreatedAfter, CreatedBefore, UpdatedAfter, UpdatedBefore *string
+}
+
+// values converts the arguments into the URL parameters of a request to list reviews.
+func (args *reviewsArgs) values() url.Values {
+	query := url.Values{}
+	for param, value := range map[string]*string{
+		"sort":           args.Sort,
+		"page":           args.After,
+		"requester":      args.Requester,
+		"reviewer":       args.Reviewer,
+		"target":         args.Target,
+		"state":          args.State,
+		"path":           args.Path,
+		"description":    args.Description,
+		"created_after":  args.CreatedAfter,
+		"created_before": args.CreatedBefore,
+		"updated_after":  args.UpdatedAfter,
+		"updated_before": args.UpdatedBefore,
+	} {
+		if value != nil {
+			query.Set(param, *value)
+		}
+	}
+	if args.First != nil {
+		query.Set("page_size", strconv.Itoa(int(*args.First)))
+	}
+	return query
+}
+
+func (r *repoResolver) Reviews(ctx context.Context, args reviewsArgs) (*reviewPageResolver, error) {
+	query, err := parseReviewListQuery(args.values())
+	if err != nil {
+		return nil, err
+	}
+	if r.listed {
+		if args.First == nil {
+			query.PageSize = maxListedRepoReviews
+		} else if query.PageSize > maxListedRepoReviews {
+			return nil, fmt.Errorf("The first argument of the reviews of listed repositories cannot exceed %d", maxListedRepoReviews)
+		}
+	}
+	var page *ReviewListResponse
+	status := reviewStatusOpen
+	if args.Status != nil {
+		status = *args.Status
+	}
+	switch status {
+	case reviewStatusOpen:
+		page, err = r.details.GetOpenReviews(ctx, query)
+	case reviewStatusClosed:
+		page, err = r.details.GetClosedReviews(ctx, query)
+	default:
+		return nil, errors.New("Invalid status argument")
+	}
+	if err != nil {
+		return nil, err
+	}
+	items := []*reviewResolver{}
+	for i := range page.Items {
+		items = append(items, &reviewResolver{repo: r.details, summary: &page.Items[i]})
+	}
+	return &reviewPageResolver{items: items, nextPageToken: page.NextPageToken}, nil
+}
+
+func (r *repoResolver) Review(ctx context.Context, args struct{ Revision string }) (*reviewResolver, error) {
+	if err := r.details.checkHash(args.Revision); err != nil {
+		return nil, nil
+	}
+	details, err := r.details.GetReview(ctx, args.Revision)
+	if err == errUnknownReview {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resolver := &reviewResolver{repo: r.details, summary: details.Summary}
+	resolver.once.Do(func() { resolver.details = details })
+	return resolver, nil
+}
+
+func (r *repoResolver) FindReview(ctx context.Context, args struct{ Ref string }) (*reviewResolver, error) {
+	revision, err := r.details.FindReview(ctx, args.Ref)
+	if err == errUnknownReview {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.Review(ctx, struct{ Revision string }{revision})
+}
+
+func (r *repoResolver) Commit(ctx context.Context, args struct{ Hash string }) (*commitResolver, error) {
+	if err := r.details.checkHash(args.Hash); err != nil {
+		return nil, nil
+	}
+	repo := repoWithContext(ctx, r.details.Repo)
+	if _, err := repo.GetCommitHash(args.Hash); err != nil {
+		if ctx.Err() != nil {
+			return nil, checkContext(ctx, "finding commit "+args.Hash, err)
+		}
+		return nil, nil
+	}
+	return newCommitResolver(ctx, repo, args.Hash)
+}
+
+type reviewPageResolver struct {
+	items         []*reviewResolver
+	nextPageToken string
+}
+
+func (r *reviewPageResolver) Items() []*reviewResolver {
+	return r.items
+}
+
+func (r *reviewPageResolver) NextPageToken() *string {
+	return optionalString(r.nextPageToken)
+}
+
+// reviewResolver resolves the fields of a review. The fields that are not part of the review's
+// summary are read from its details, which are only loaded once they are needed.
+type reviewResolver struct {
+	repo    *RepoDetails
+	summary *review.Summary
+
+	once    sync.Once
+	details *review.Review
+	err     error
+}
+
+func (r *reviewResolver) getDetails(ctx context.Context) (*review.Review, error) {
+	r.once.Do(func() {
+		r.details, r.err = r.repo.GetReview(ctx, r.summary.Revision)
+	})
+	if r.err != nil {
+		return nil, r.err
+	}
+	return reviewWithContext(ctx, r.details), nil
+}
+
+func (r *reviewResolver) Revision() string {
+	return r.summary.Revision
+}
+
+func (r *reviewResolver) Requester() *string {
+	return optionalString(r.summary.Request.Requester)
+}
+
+func (r *reviewResolver) Reviewers() []string {
+	if r.summary.Request.Reviewers == nil {
+		return []string{}
+	}
+	return r.summary.Request.Reviewers
+}
+
+func (r *reviewResolver) Description() *string {
+	return optionalString(r.summary.Request.Description)
+}
+
+func (r *reviewResolver) TargetRef() *string {
+	return optionalString(r.summary.Request.TargetRef)
+}
+
+func (r *reviewResolver) ReviewRef() *string {
+	return optionalString(r.summary.Request.ReviewRef)
+}
+
+func (r *reviewResolver) BaseCommit() *string {
+	return optionalString(r.summary.Request.BaseCommit)
+}
+
+func (r *reviewResolver) CreatedAt() *string {
+	return optionalTime(getCreationTime(r.summary))
+}
+
+func (r *reviewResolver) UpdatedAt() *string {
+	return optionalTime(getLatestActivity(r.summary))
+}
+
+func (r *reviewResolver) Submitted() bool {
+	return r.summary.Submitted
+}
+
+func (r *reviewResolver) Abandoned() bool {
+	return r.summary.IsAbandoned()
+}
+
+func (r *reviewResolver) Resolved() *bool {
+	return r.summary.Resolved
+}
+
+func (r *reviewResolver) State() string {
+	switch {
+	case r.summary.Resolved == nil:
+		return reviewStatePending
+	case *r.summary.Resolved:
+		return reviewStateAccepted
+	}
+	return reviewStateRejected
+}
+
+func (r *reviewResolver) Threads() []*threadResolver {
+	return newThreadResolvers(r.summary.Comments)
+}
+
+func (r *reviewResolver) UnresolvedThreadCount() int32 {
+	var count int32
+	for _, thread := range r.summary.Comments {
+		if thread.Resolved == nil || !*thread.Resolved {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *reviewResolver) Reports(ctx context.Context) ([]*reportResolver, error) {
+	details, err := r.getDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reports := []*reportResolver{}
+	for i := range details.Reports {
+		reports = append(reports, &reportResolver{&details.Reports[i]})
+	}
+	return reports, nil
+}
+
+func (r *reviewResolver) LatestReport(ctx context.Context) (*reportResolver, error) {
+	details, err := r.getDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := ci.GetLatestCIReport(details.Reports)
+	if err != nil || latest == nil {
+		return nil, nil
+	}
+	return &reportResolver{latest}, nil
+}
+
+func (r *reviewResolver) Analyses(ctx context.Context) ([]*analysisResolver, error) {
+	details, err := r.getDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reports := []*analysisResolver{}
+	for i := range details.Analyses {
+		reports = append(reports, &analysisResolver{&details.Analyses[i]})
+	}
+	return reports, nil
+}
+
+func (r *reviewResolver) HeadCommit(ctx context.Context) (*commitResolver, error) {
+	details, err := r.getDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head, err := details.GetHeadCommit()
+	if err != nil {
+		return nil, checkContext(ctx, "finding the head of the review", err)
+	}
+	return newCommitResolver(ctx, details.Repo, head)
+}
+
+// Commits lists the commits in the review, starting with its base commit, as in the review's diff.
+func (r *reviewResolver) Commits(ctx context.Context) ([]*commitResolver, error) {
+	if err := chargeGraphQLDiff(ctx); err != nil {
+		return nil, err
+	}
+	details, err := r.getDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := NewDiffSummary(ctx, details, "", "")
+	if err != nil {
+		return nil, err
+	}
+	commits := []*commitResolver{}
+	for _, overview := range diff.ReviewCommits {
+		commits = append(commits, &commitResolver{hash: overview.ID, details: overview.Details})
+	}
+	return commits, nil
+}
+
+func (r *reviewResolver) Diff(ctx context.Context, args struct{ Lhs, Rhs *string }) (*diffResolver, error) {
+	if err := chargeGraphQLDiff(ctx); err != nil {
+		return nil, err
+	}
+	details, err := r.getDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var lhs, rhs string
+	if args.Lhs != nil {
+		lhs = *args.Lhs
+	}
+	if args.Rhs != nil {
+		rhs = *args.Rhs
+	}
+	if r.repo.checkHash(lhs) != nil {
+		return nil, errors.New("Invalid lhs argument")
+	}
+	if r.repo.checkHash(rhs) != nil {
+		return nil, errors.New("Invalid rhs argument")
+	}
+	diff, err := NewDiffSummary(ctx, details, lhs, rhs)
+	if err != nil {
+		return nil, err
+	}
+	return &diffResolver{diff}, nil
+}
+
+type diffResolver struct {
+	diff *DiffSummary
+}
+
+func (r *diffResolver) LeftHandSide() string {
+	return r.diff.LeftHandSide
+}
+
+func (r *diffResolver) RightHandSide() string {
+	return r.diff.RightHandSide
+}
+
+func (r *diffResolver) Contents() string {
+	return r.diff.Contents
+}
+
+type threadResolver struct {
+	thread *review.CommentThread
+}
+
+func newThreadResolvers(threads []review.CommentThread) []*threadResolver {
+	resolvers := []*threadResolver{}
+	for i := range threads {
+		resolvers = append(resolvers, &threadResolver{&threads[i]})
+	}
+	return resolvers
+}
+
+func (r *threadResolver) Hash() *string {
+	return optionalString(r.thread.Hash)
+}
+
+func (r *threadResolver) Author() *string {
+	return optionalString(r.thread.Comment.Author)
+}
+
+func (r *threadResolver) Timestamp() *string {
+	return optionalString(r.thread.Comment.Timestamp)
+}
+
+func (r *threadResolver) Description() *string {
+	return optionalString(r.thread.Comment.Description)
+}
+
+func (r *threadResolver) Location() *locationResolver {
+	if r.thread.Comment.Location == nil {
+		return nil
+	}
+	return &locationResolver{r.thread.Comment.Location}
+}
+
+func (r *threadResolver) Resolved() *bool {
+	return r.thread.Resolved
+}
+
+func (r *threadResolver) Edited() bool {
+	return r.thread.Edited
+}
+
+func (r *threadResolver) Children() []*threadResolver {
+	return newThreadResolvers(r.thread.Children)
+}
+
+type locationResolver struct {
+	location *comment.Location
+}
+
+func (r *locationResolver) Commit() *string {
+	return optionalString(r.location.Commit)
+}
+
+func (r *locationResolver) Path() *string {
+	return optionalString(r.location.Path)
+}
+
+func (r *locationResolver) StartLine() *int32 {
+	if r.location.Range == nil {
+		return nil
+	}
+	line := int32(r.location.Range.StartLine)
+	return &line
+}
+
+func (r *locationResolver) EndLine() *int32 {
+	if r.location.Range == nil || r.location.Range.EndLine == 0 {
+		return nil
+	}
+	line := int32(r.location.Range.EndLine)
+	return &line
+}
+
+type reportResolver struct {
+	report *ci.Report
+}
+
+func (r *reportResolver) Timestamp() *string {
+	return optionalString(r.report.Timestamp)
+}
+
+func (r *reportResolver) URL() *string {
+	return optionalString(r.report.URL)
+}
+
+func (r *reportResolver) Status() *string {
+	return optionalString(r.report.Status)
+}
+
+func (r *reportResolver) Agent() *string {
+	return optionalString(r.report.Agent)
+}
+
+type analysisResolver struct {
+	report *analyses.Report
+}
+
+func (r *analysisResolver) Timestamp() *string {
+	return optionalString(r.report.Timestamp)
+}
+
+func (r *analysisResolver) URL() *string {
+	return optionalString(r.report.URL)
+}
+
+func (r *analysisResolver) Status() *string {
+	return optionalString(r.report.Status)
+}
+
+type commitResolver struct {
+	hash    string
+	details *repository.CommitDetails
+}
+
+func newCommitResolver(ctx context.Context, repo repository.Repo, hash string) (*commitResolver, error) {
+	details, err := repo.GetCommitDetails(hash)
+	if err != nil {
+		return nil, checkContext(ctx, "reading the details of commit "+hash, err)
+	}
+	return &commitResolver{hash: hash, details: details}, nil
+}
+
+func (r *commitResolver) Hash() string {
+	return r.hash
+}
+
+func (r *commitResolver) Author() *string {
+	return optionalString(r.details.Author)
+}
+
+func (r *commitResolver) AuthorEmail() *string {
+	return optionalString(r.details.AuthorEmail)
+}
+
+func (r *commitResolver) Time() *string {
+	return optionalString(r.details.Time)
+}
+
+func (r *commitResolver) Summary() *string {
+	return optionalString(r.details.Summary)
+}
+
+func (r *commitResolver) Parents() []string {
+	if r.details.Parents == nil {
+		return []string{}
+	}
+	return r.details.Parents
+}
+
+// graphQLCost counts the expensive fields resolved by a single query.
+type graphQLCost struct {
+	diffs int32
+}
+
+type graphQLCostKey struct{}
+
+// chargeGraphQLDiff counts a diff, or list of commits, against the limit of the query being
+// resolved under the given context, and returns an error once that limit is exceeded.
+func chargeGraphQLDiff(ctx context.Context) error {
+	cost, ok := ctx.Value(graphQLCostKey{}).(*graphQLCost)
+	if !ok {
+		return nil
+	}
+	// Fields are resolved concurrently, so the count is updated atomically.
+	if atomic.AddInt32(&cost.diffs, 1) > maxGraphQLDiffs {
+		return fmt.Errorf("The query reads more than %d diffs and lists of commits", maxGraphQLDiffs)
+	}
+	return nil
+}
+
+// countGraphQLAliases counts the aliased fields in the given query.
+//
+// An alias is the only colon outside of parentheses, since arguments, variable definitions, and
+// directives are all parenthesized. Strings and comments are skipped, as they may contain colons.
+func countGraphQLAliases(query string) int {
+	aliases, depth := 0, 0
+	for i := 0; i < len(query); i++ {
+		switch query[i] {
+		case '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case '"':
+			if strings.HasPrefix(query[i:], `"""`) {
+				end := strings.Index(query[i+3:], `"""`)
+				if end < 0 {
+					return aliases
+				}
+				i += 3 + end + 2
+				continue
+			}
+			for i++; i < len(query) && query[i] != '"' && query[i] != '\n'; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ':':
+			if depth == 0 {
+				aliases++
+			}
+		}
+	}
+	return aliases
+}
+
+// graphQLHandler serves GraphQL queries, either as the "query", "operationName", and "variables"
+// URL parameters of a GET request, or as the same fields of the JSON body of a POST request.
+type graphQLHandler struct {
+	schema *graphql.Schema
+}
+
+// GraphQLHandler returns the handler for GraphQL queries over the cache's repositories.
+func (cache *RepoCache) GraphQLHandler() http.Handler {
+	schema := graphql.MustParseSchema(graphQLSchema, &graphQLRoot{cache: cache}, graphql.MaxDepth(maxGraphQLDepth))
+	return &graphQLHandler{schema: schema}
+}
+
+func (h *graphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		params.Query = query.Get("query")
+		params.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &params.Variables); err != nil {
+				http.Error(w, "Invalid variables parameter", http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLRequestBytes)).Decode(&params); err != nil {
+			http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if params.Query == "" {
+		http.Error(w, "No query specified", http.StatusBadRequest)
+		return
+	}
+	if countGraphQLAliases(params.Query) > maxGraphQLAliases {
+		http.Error(w, fmt.Sprintf("The query has more than %d aliases", maxGraphQLAliases), http.StatusBadRequest)
+		return
+	}
+	ctx := context.WithValue(r.Context(), graphQLCostKey{}, &graphQLCost{})
+	response := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	w.Header().Set("Content-Type", "application/json")
+	serveJSON(response, w)
+}
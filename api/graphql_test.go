@@ -0,0 +1,179 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestGraphQL(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	feature := runTestGit(t, dir, "rev-parse", "feature")
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/discuss", "add", "-m",
+		`{"timestamp":"0000000100","author":"bob","description":"LGTM","resolved":true}`, feature)
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/discuss", "append", "-m",
+		`{"timestamp":"0000000200","author":"carol","location":{"commit":"`+feature+`","path":"README.md","range":{"startLine":1}},"description":"Typo"}`, feature)
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/ci", "add", "-m",
+		`{"timestamp":"0000000300","url":"https://ci.example.com/1","status":"success","agent":"ci"}`, feature)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewRepoCache()
+	repoDetails := cache.AddConfiguredRepo(repo, RepoConfig{Slug: "test"})
+	if err := repoDetails.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	handler := cache.GraphQLHandler()
+
+	query := `query($repo: String!) {
+		repo(id: $repo) {
+			id
+			openReviewCount
+			reviews(first: 10) {
+				items {
+					revision
+					description
+					state
+					unresolvedThreadCount
+					threads { author location { path startLine } }
+					latestReport { status agent }
+					headCommit { hash summary }
+					commits { summary }
+				}
+				nextPageToken
+			}
+		}
+		missing: repo(id: "missing") { id }
+	}`
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": map[string]string{"repo": "test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/graphql", strings.NewReader(string(body))))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Unexpected response: %d %q", w.Code, w.Body.String())
+	}
+	var response struct {
+		Data struct {
+			Repo struct {
+				ID              string
+				OpenReviewCount int
+				Reviews         struct {
+					Items []struct {
+						Revision              string
+						Description           string
+						State                 string
+						UnresolvedThreadCount int
+						Threads               []struct {
+							Author   string
+							Location *struct {
+								Path      string
+								StartLine int
+							}
+						}
+						LatestReport *struct{ Status, Agent string }
+						HeadCommit   struct{ Hash, Summary string }
+						Commits      []struct{ Summary string }
+					}
+					NextPageToken *string
+				}
+			}
+			Missing *struct{ ID string }
+		}
+		Errors []json.RawMessage
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Errors) != 0 {
+		t.Fatalf("Unexpected errors: %s", w.Body.String())
+	}
+	r := response.Data.Repo
+	if r.ID != "test" || r.OpenReviewCount != 1 || len(r.Reviews.Items) != 1 || r.Reviews.NextPageToken != nil || response.Data.Missing != nil {
+		t.Fatalf("Unexpected response: %s", w.Body.String())
+	}
+	item := r.Reviews.Items[0]
+	if item.Revision != feature || item.Description != "Feature" || item.State != reviewStateAccepted || item.UnresolvedThreadCount != 1 {
+		t.Errorf("Unexpected review: %+v", item)
+	}
+	if len(item.Threads) != 2 || item.Threads[1].Location == nil || item.Threads[1].Location.Path != "README.md" || item.Threads[1].Location.StartLine != 1 {
+		t.Errorf("Unexpected threads: %+v", item.Threads)
+	}
+	if item.LatestReport == nil || item.LatestReport.Status != "success" || item.LatestReport.Agent != "ci" {
+		t.Errorf("Unexpected latest report: %+v", item.LatestReport)
+	}
+	if item.HeadCommit.Hash != feature || item.HeadCommit.Summary != "Feature commit" {
+		t.Errorf("Unexpected head commit: %+v", item.HeadCommit)
+	}
+	if len(item.Commits) != 2 || item.Commits[1].Summary != "Feature commit" {
+		t.Errorf("Unexpected commits: %+v", item.Commits)
+	}
+
+	// Queries can also be sent as URL parameters.
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/graphql?query="+url.QueryEscape(`{ repos { id name } }`), nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"id": "test"`) {
+		t.Errorf("Unexpected response to a GET request: %d %q", w.Code, w.Body.String())
+	}
+
+	for _, tc := range []struct {
+		method, body string
+		code         int
+		contains     string
+	}{
+		{"POST", `{"query": "{ repo(id: \"test\") { reviews(status: \"merged\") { nextPageToken } } }"}`, http.StatusOK, "Invalid status"},
+		{"POST", `{"query": "{ repo(id: \"test\") { nonexistent } }"}`, http.StatusOK, "Cannot query field"},
+		{"POST", `{"query": "{ repos { reviews(first: 21) { nextPageToken } } }"}`, http.StatusOK, "cannot exceed 20"},
+		{"POST", `{"query": "{ repos { reviews(first: 20) { nextPageToken } } }"}`, http.StatusOK, `"nextPageToken": null`},
+		{"POST", `{"query": "{ repo(id: \"test\") { reviews { items { commits { hash } ` +
+			`d1: diff { contents } d2: diff { contents } d3: diff { contents } d4: diff { contents } d5: diff { contents } ` +
+			`d6: diff { contents } d7: diff { contents } d8: diff { contents } d9: diff { contents } d10: diff { contents } } } } }"}`,
+			http.StatusOK, "more than 10 diffs"},
+		{"POST", `{"query": "{ ` + strings.Repeat("r: repos { id } ", maxGraphQLAliases+1) + `}"}`, http.StatusBadRequest, "more than 20 aliases"},
+		{"POST", `{}`, http.StatusBadRequest, "No query"},
+		{"POST", `not json`, http.StatusBadRequest, "Invalid request body"},
+		{"PUT", `{}`, http.StatusMethodNotAllowed, "Method not allowed"},
+	} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(tc.method, "/graphql", strings.NewReader(tc.body)))
+		if w.Code != tc.code || !strings.Contains(w.Body.String(), tc.contains) {
+			t.Errorf("Unexpected response for %s %s: %d %q", tc.method, tc.body, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestCountGraphQLAliases(t *testing.T) {
+	for query, expected := range map[string]int{
+		`{ repos { id } }`: 0,
+		`query($repo: String! = "a:b") { repo(id: $repo) { reviews(first: 10, path: "c:d") { nextPageToken } } }`: 0,
+		`{ a: repo(id: "x") { id } b: repo(id: "y") { n: name } }`:                                                3,
+		"{ # not: an alias\n repo(id: \"x:\\\"y\") { id } }":                                                      0,
+		`{ repo(id: """a: "b" c""") { d: id } }`:                                                                  1,
+	} {
+		if aliases := countGraphQLAliases(query); aliases != expected {
+			t.Errorf("Unexpected number of aliases in %q: %d", query, aliases)
+		}
+	}
+}
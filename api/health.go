@@ -0,0 +1,91 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RepoStatus reports the health of the server's view of a single repository.
+type RepoStatus struct {
+	ID   string `json:"id"`
+	Path string `json:"path"`
+	// Indexed is set once the reviews in the repository have been read at least once.
+	Indexed bool `json:"indexed"`
+	// Healthy indicates that the most recent attempt to read the repository succeeded.
+	Healthy     bool       `json:"healthy"`
+	LastRefresh *time.Time `json:"lastRefresh,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// ReadinessReport is the return type for the readiness check.
+type ReadinessReport struct {
+	Ready bool          `json:"ready"`
+	Repos []*RepoStatus `json:"repos"`
+	// Error explains why the server is not ready when that is not just because it is still starting.
+	Error string `json:"error,omitempty"`
+}
+
+// GetReadinessReport reports the status of every repository in the cache.
+//
+// The server is not ready if it has no repositories to serve, even once the initial index completes.
+func (cache *RepoCache) GetReadinessReport() *ReadinessReport {
+	cache.mu.RLock()
+	indexed := cache.indexed
+	cache.mu.RUnlock()
+	report := &ReadinessReport{
+		Ready: indexed,
+		Repos: []*RepoStatus{},
+	}
+	for _, repoDetails := range cache.list() {
+		report.Repos = append(report.Repos, repoDetails.GetStatus())
+	}
+	if indexed && len(report.Repos) == 0 {
+		report.Ready = false
+		report.Error = "Unable to find any repositories to serve"
+	}
+	return report
+}
+
+// ServeHealthz writes the result of the liveness check to the given writer.
+//
+// This succeeds for as long as the server is able to handle requests.
+func (cache *RepoCache) ServeHealthz(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "ok")
+}
+
+// ServeReadyz writes the result of the readiness check to the given writer.
+//
+// The response includes the status of every repository, and has a
+// "503 Service Unavailable" status until the initial index of the repositories completes,
+// and for as long as there are no repositories to serve.
+func (cache *RepoCache) ServeReadyz(w http.ResponseWriter, r *http.Request) {
+	report := cache.GetReadinessReport()
+	json, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(json)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/git-appraise/repository"
+)
+
+func getReadiness(t *testing.T, cache *RepoCache) (int, *ReadinessReport) {
+	w := httptest.NewRecorder()
+	cache.ServeReadyz(w, httptest.NewRequest("GET", "/readyz", nil))
+	var report ReadinessReport
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	return w.Code, &report
+}
+
+func TestReadiness(t *testing.T) {
+	cache := NewRepoCache()
+	cache.AddRepo(repository.NewMockRepoForTest())
+
+	code, report := getReadiness(t, cache)
+	if code != http.StatusServiceUnavailable || report.Ready {
+		t.Fatalf("Unexpected readiness before the initial index: %d, %+v", code, report)
+	}
+	if len(report.Repos) != 1 || report.Repos[0].Indexed || report.Repos[0].LastRefresh != nil {
+		t.Fatalf("Unexpected repository status before the initial index: %+v", report.Repos)
+	}
+
+	cache.Index()
+	code, report = getReadiness(t, cache)
+	if code != http.StatusOK || !report.Ready {
+		t.Fatalf("Unexpected readiness after the initial index: %d, %+v", code, report)
+	}
+	status := report.Repos[0]
+	if !status.Indexed || !status.Healthy || status.LastRefresh == nil || status.Error != "" {
+		t.Fatalf("Unexpected repository status after the initial index: %+v", status)
+	}
+}
+
+func TestReadinessWithoutRepos(t *testing.T) {
+	cache := NewRepoCache()
+	cache.Index()
+	code, report := getReadiness(t, cache)
+	if code != http.StatusServiceUnavailable || report.Ready || report.Error == "" {
+		t.Fatalf("Unexpected readiness without any repositories: %d, %+v", code, report)
+	}
+
+	cache.AddRepo(repository.NewMockRepoForTest())
+	cache.Index()
+	code, report = getReadiness(t, cache)
+	if code != http.StatusOK || !report.Ready || report.Error != "" {
+		t.Fatalf("Unexpected readiness once a repository is added: %d, %+v", code, report)
+	}
+}
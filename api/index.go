@@ -0,0 +1,253 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/git-appraise/repository"
+	"github.com/google/git-appraise/review"
+	"github.com/google/git-appraise/review/comment"
+	"github.com/google/git-appraise/review/request"
+)
+
+// The notes refs that review summaries are read from.
+var summaryNotesRefs = []string{request.Ref, comment.Ref}
+
+var errIncrementalUnsupported = errors.New("The repository does not support incremental updates")
+
+// indexableRepo is implemented by Repos that support incrementally updating a review index.
+type indexableRepo interface {
+	// listRefs returns a map from the name of every ref in the repository to the object it points to.
+	listRefs() (map[string]string, error)
+	// changedNotes returns the revisions whose notes differ between the two given commits of a notes ref.
+	changedNotes(from, to string) ([]string, error)
+}
+
+// reviewIndex is an immutable snapshot of the reviews in a repository.
+//
+// The open and closed reviews are kept in the default order that reviews are listed in.
+type reviewIndex struct {
+	repoState string
+	// refs maps every ref in the repository to the object it pointed to before the
+	// reviews were read. This is nil if the repository does not support incremental updates.
+	refs              map[string]string
+	reviews           map[string]review.Summary
+	openReviewCount   int
+	openReviews       []review.Summary
+	closedReviewCount int
+	closedReviews     []review.Summary
+	// awaitingActionCount is the number of open reviews that no reviewer has accepted or rejected yet.
+	awaitingActionCount int
+	// latestActivity is the time of the most recent review request or comment, or zero if there are none.
+	latestActivity time.Time
+	// touched and commits cache the paths of the files changed by each review, and the commits in
+	// each review, which only change along with the index.
+	touched *revisionLists
+	commits *revisionLists
+	// commitReviews maps each commit in a review to the review that FindReview prefers among those
+	// containing it. It is built by the first lookup that needs it, which holds commitReviewsLock.
+	commitReviewsLock chan struct{}
+	commitReviews     map[string]string
+}
+
+// newReviewIndex reads every review in the given repository, which has the given state.
+func newReviewIndex(repo repository.Repo, repoState string) *reviewIndex {
+	var refs map[string]string
+	if indexable, ok := repo.(indexableRepo); ok {
+		// The refs have to be read before the reviews, so that any changes made while
+		// the reviews are being read get picked up by the next update.
+		if allRefs, err := indexable.listRefs(); err == nil {
+			refs = allRefs
+		}
+	}
+	reviews := make(map[string]review.Summary)
+	for _, summary := range review.ListAll(repo) {
+		reviews[summary.Revision] = summary
+	}
+	return buildReviewIndex(repoState, refs, reviews)
+}
+
+// buildReviewIndex constructs a review index from the given set of reviews.
+func buildReviewIndex(repoState string, refs map[string]string, reviews map[string]review.Summary) *reviewIndex {
+	var allReviews []review.Summary
+	for _, summary := range reviews {
+		allReviews = append(allReviews, summary)
+	}
+	// Ties are broken by revision, so the order does not depend on the order of the map.
+	sortReviews(allReviews, defaultReviewOrder)
+	var openReviews []review.Summary
+	var closedReviews []review.Summary
+	var awaitingActionCount int
+	var latestActivity time.Time
+	for i := range allReviews {
+		review := &allReviews[i]
+		if review.Submitted || review.Request.TargetRef == "" {
+			closedReviews = append(closedReviews, *review)
+		} else {
+			openReviews = append(openReviews, *review)
+			if review.Resolved == nil {
+				awaitingActionCount++
+			}
+		}
+		if activity := getLatestActivity(review); activity.After(latestActivity) {
+			latestActivity = activity
+		}
+	}
+	return &reviewIndex{
+		repoState:           repoState,
+		refs:                refs,
+		reviews:             reviews,
+		openReviewCount:     len(openReviews),
+		openReviews:         openReviews,
+		closedReviewCount:   len(closedReviews),
+		closedReviews:       closedReviews,
+		awaitingActionCount: awaitingActionCount,
+		latestActivity:      latestActivity,
+		touched:             newRevisionLists(),
+		commits:             newRevisionLists(),
+		commitReviewsLock:   make(chan struct{}, 1),
+	}
+}
+
+// parseTimestamp parses a timestamp from a review request or comment, which is the number of
+// seconds since the epoch, and returns the zero time if it is invalid.
+func parseTimestamp(timestamp string) time.Time {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+// getLatestActivity returns the time of the most recent request or comment in the given review.
+func getLatestActivity(summary *review.Summary) time.Time {
+	latest := parseTimestamp(summary.Request.Timestamp)
+	for _, request := range summary.AllRequests {
+		if t := parseTimestamp(request.Timestamp); t.After(latest) {
+			latest = t
+		}
+	}
+	var visit func(threads []review.CommentThread)
+	visit = func(threads []review.CommentThread) {
+		for _, thread := range threads {
+			if t := parseTimestamp(thread.Comment.Timestamp); t.After(latest) {
+				latest = t
+			}
+			visit(thread.Children)
+		}
+	}
+	visit(summary.Comments)
+	return latest
+}
+
+// getStartingCommit returns the commit that determines whether or not the given review was submitted.
+func getStartingCommit(summary *review.Summary) string {
+	if summary.Request.Alias != "" {
+		return summary.Request.Alias
+	}
+	return summary.Revision
+}
+
+// update constructs a new index for the given repository, which has changed since this
+// index was built, by re-reading only the reviews whose notes changed.
+//
+// The submitted status of every review whose target ref moved is also recomputed.
+func (index *reviewIndex) update(repo repository.Repo, repoState string) (*reviewIndex, error) {
+	indexable, ok := repo.(indexableRepo)
+	if !ok {
+		return nil, errIncrementalUnsupported
+	}
+	refs, err := indexable.listRefs()
+	if err != nil {
+		return nil, err
+	}
+
+	changedRevisions := make(map[string]bool)
+	for _, notesRef := range summaryNotesRefs {
+		oldCommit, newCommit := index.refs[notesRef], refs[notesRef]
+		if oldCommit == newCommit {
+			continue
+		}
+		if oldCommit == "" || newCommit == "" {
+			return nil, errors.New("The notes ref " + notesRef + " was created or deleted")
+		}
+		revisions, err := indexable.changedNotes(oldCommit, newCommit)
+		if err != nil {
+			return nil, err
+		}
+		for _, revision := range revisions {
+			changedRevisions[revision] = true
+		}
+	}
+
+	reviews := make(map[string]review.Summary)
+	for revision, summary := range index.reviews {
+		if !changedRevisions[revision] {
+			reviews[revision] = summary
+		}
+	}
+	for revision := range changedRevisions {
+		summary, err := review.GetSummary(repo, revision)
+		if err != nil || summary == nil {
+			// There is no longer a review for this revision.
+			continue
+		}
+		reviews[revision] = *summary
+	}
+
+	// Reviews that were just re-read already have an up-to-date submitted status.
+	submittedChecks := make(map[string]map[string]bool)
+	for revision, summary := range reviews {
+		targetRef := summary.Request.TargetRef
+		if changedRevisions[revision] || summary.IsAbandoned() {
+			continue
+		}
+		if oldCommit, ok := index.refs[targetRef]; ok && oldCommit == refs[targetRef] {
+			continue
+		}
+		targetCommits, ok := submittedChecks[targetRef]
+		if !ok {
+			targetCommits = make(map[string]bool)
+			for _, commit := range repo.ListCommits(targetRef) {
+				targetCommits[commit] = true
+			}
+			submittedChecks[targetRef] = targetCommits
+		}
+		summary.Submitted = targetCommits[getStartingCommit(&summary)]
+		reviews[revision] = summary
+	}
+
+	updated := buildReviewIndex(repoState, refs, reviews)
+	// The paths touched by a review, and its commits, are kept as long as its notes, review ref, and
+	// target ref are unchanged, since those determine the review's base and head commits.
+	for revision, summary := range reviews {
+		reviewRef, targetRef := summary.Request.ReviewRef, summary.Request.TargetRef
+		if changedRevisions[revision] || index.refs[reviewRef] != refs[reviewRef] || index.refs[targetRef] != refs[targetRef] {
+			continue
+		}
+		if paths, ok := index.touched.get(revision); ok {
+			updated.touched.add(revision, paths)
+		}
+		if commits, ok := index.commits.get(revision); ok {
+			updated.commits.add(revision, commits)
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/git-appraise/repository"
+	"github.com/google/git-appraise/review"
+)
+
+// notesCountingRepo is a gitRepo that counts how many times all of the notes in a ref are read.
+type notesCountingRepo struct {
+	*gitRepo
+	getAllNotesCalls int
+}
+
+func (repo *notesCountingRepo) GetAllNotes(notesRef string) (map[string][]repository.Note, error) {
+	repo.getAllNotesCalls++
+	return repo.gitRepo.GetAllNotes(notesRef)
+}
+
+// indexedReviews describes the given reviews by the revision and submitted state of each review.
+func indexedReviews(reviews []review.Summary) []string {
+	var descriptions []string
+	for _, summary := range reviews {
+		descriptions = append(descriptions, fmt.Sprintf("%s:%t", summary.Revision, summary.Submitted))
+	}
+	return descriptions
+}
+
+func checkIndexMatchesFullRebuild(t *testing.T, repo repository.Repo, index *reviewIndex) {
+	t.Helper()
+	fullIndex := newReviewIndex(repo, index.repoState)
+	if !reflect.DeepEqual(indexedReviews(index.openReviews), indexedReviews(fullIndex.openReviews)) ||
+		!reflect.DeepEqual(indexedReviews(index.closedReviews), indexedReviews(fullIndex.closedReviews)) {
+		t.Fatalf("Incremental index %+v does not match the full index %+v", index, fullIndex)
+	}
+}
+
+func TestIncrementalIndexUpdate(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	baseRepo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &notesCountingRepo{gitRepo: baseRepo.(*gitRepo)}
+	repoDetails := NewRepoDetails(repo)
+
+	index, err := repoDetails.update()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.refs == nil || index.openReviewCount != 1 || repo.getAllNotesCalls != 2 {
+		t.Fatalf("Unexpected initial index: %+v", index)
+	}
+
+	runTestGit(t, dir, "checkout", "-q", "-b", "other-feature")
+	runTestGit(t, dir, "commit", "-q", "--allow-empty", "-m", "Other feature commit")
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/reviews", "add", "-m",
+		`{"timestamp":"2","reviewRef":"refs/heads/other-feature","targetRef":"refs/heads/master","description":"Other feature"}`, "HEAD")
+	runTestGit(t, dir, "checkout", "-q", "master")
+	index, err = repoDetails.update()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.getAllNotesCalls != 2 {
+		t.Fatalf("Adding a review triggered a full rebuild")
+	}
+	if index.openReviewCount != 2 || index.closedReviewCount != 0 {
+		t.Fatalf("Unexpected index after adding a review: %+v", index)
+	}
+	checkIndexMatchesFullRebuild(t, baseRepo, index)
+
+	runTestGit(t, dir, "merge", "-q", "--ff-only", "feature")
+	index, err = repoDetails.update()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.getAllNotesCalls != 2 {
+		t.Fatalf("Submitting a review triggered a full rebuild")
+	}
+	if index.openReviewCount != 1 || index.closedReviewCount != 1 || !index.closedReviews[0].Submitted {
+		t.Fatalf("Unexpected index after submitting a review: %+v", index)
+	}
+	checkIndexMatchesFullRebuild(t, baseRepo, index)
+}
@@ -0,0 +1,647 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/git-appraise/repository"
+)
+
+const (
+	// How often the rate limiter drops the state it keeps for idle clients.
+	rateLimiterSweepInterval = time.Minute
+)
+
+// semaphore is a counting semaphore. A nil semaphore places no limit.
+type semaphore chan struct{}
+
+func newSemaphore(size int) semaphore {
+	if size <= 0 {
+		return nil
+	}
+	return make(semaphore, size)
+}
+
+// acquire takes a slot in the semaphore, waiting until one is free or the given context is done.
+//
+// The return value reports whether or not a slot was taken.
+func (s semaphore) acquire(ctx context.Context) bool {
+	if s == nil {
+		return true
+	}
+	select {
+	case s <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s semaphore) release() {
+	if s != nil {
+		<-s
+	}
+}
+
+// GitLimiter bounds the number of git commands that can run at the same time.
+//
+// There is one limit shared by all repositories, and a separate limit for each individual
+// repository, so that a burst of requests against one repository cannot monopolize the host.
+// A value of zero for either limit means that limit is not enforced.
+type GitLimiter struct {
+	global     semaphore
+	maxPerRepo int
+
+	mu      sync.Mutex
+	perRepo map[string]semaphore
+}
+
+// NewGitLimiter constructs a new GitLimiter with the given limits.
+func NewGitLimiter(maxGlobal, maxPerRepo int) *GitLimiter {
+	return &GitLimiter{
+		global:     newSemaphore(maxGlobal),
+		maxPerRepo: maxPerRepo,
+		perRepo:    make(map[string]semaphore),
+	}
+}
+
+func (limiter *GitLimiter) repoSemaphore(path string) semaphore {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	s, ok := limiter.perRepo[path]
+	if !ok {
+		s = newSemaphore(limiter.maxPerRepo)
+		limiter.perRepo[path] = s
+	}
+	return s
+}
+
+// Wrap returns a Repo that forwards to the given one while enforcing the limiter's limits.
+func (limiter *GitLimiter) Wrap(repo repository.Repo) repository.Repo {
+	return &limitedRepo{
+		Repo:    repo,
+		global:  limiter.global,
+		perRepo: limiter.repoSemaphore(repo.GetPath()),
+		ctx:     context.Background(),
+	}
+}
+
+// limitedRepo is a Repo where every method that runs git holds a slot in both the
+// global and the per-repository semaphores for the duration of the call.
+//
+// Some methods run more than one git command, but they do so sequentially, so a
+// single slot per call is sufficient.
+type limitedRepo struct {
+	repository.Repo
+	global  semaphore
+	perRepo semaphore
+	ctx     context.Context
+}
+
+// WithContext returns a copy of the repo that stops waiting for a slot once the given context is done.
+//
+// The underlying repo is bound to the same context, so a call that gives up waiting
+// fails without running git.
+func (repo *limitedRepo) WithContext(ctx context.Context) repository.Repo {
+	return &limitedRepo{
+		Repo:    repoWithContext(ctx, repo.Repo),
+		global:  repo.global,
+		perRepo: repo.perRepo,
+		ctx:     ctx,
+	}
+}
+
+// acquire blocks until the repo is allowed to run git, and returns the function that
+// gives up that permission. The per-repository slot is taken first so that callers
+// waiting on a busy repository do not hold global slots in the meantime.
+//
+// If the repo's context is done before both slots are taken, then a TimeoutError is
+// returned instead, and the caller must not run git.
+func (repo *limitedRepo) acquire() (func(), error) {
+	// A free slot would otherwise be taken even though the context is already done.
+	if err := repo.ctx.Err(); err != nil {
+		return nil, &TimeoutError{Op: "waiting to run git", Err: err}
+	}
+	if !repo.perRepo.acquire(repo.ctx) {
+		return nil, &TimeoutError{Op: "waiting to run git", Err: repo.ctx.Err()}
+	}
+	if !repo.global.acquire(repo.ctx) {
+		repo.perRepo.release()
+		return nil, &TimeoutError{Op: "waiting to run git", Err: repo.ctx.Err()}
+	}
+	return func() {
+		repo.global.release()
+		repo.perRepo.release()
+	}, nil
+}
+
+func (repo *limitedRepo) GetRepoStateHash() (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetRepoStateHash()
+}
+
+func (repo *limitedRepo) GetUserEmail() (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetUserEmail()
+}
+
+func (repo *limitedRepo) GetUserSigningKey() (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetUserSigningKey()
+}
+
+func (repo *limitedRepo) GetCoreEditor() (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetCoreEditor()
+}
+
+func (repo *limitedRepo) GetSubmitStrategy() (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetSubmitStrategy()
+}
+
+func (repo *limitedRepo) HasUncommittedChanges() (bool, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return false, err
+	}
+	defer release()
+	return repo.Repo.HasUncommittedChanges()
+}
+
+func (repo *limitedRepo) VerifyCommit(hash string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.VerifyCommit(hash)
+}
+
+func (repo *limitedRepo) VerifyGitRef(ref string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.VerifyGitRef(ref)
+}
+
+func (repo *limitedRepo) GetHeadRef() (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetHeadRef()
+}
+
+func (repo *limitedRepo) GetCommitHash(ref string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetCommitHash(ref)
+}
+
+func (repo *limitedRepo) ResolveRefCommit(ref string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.ResolveRefCommit(ref)
+}
+
+func (repo *limitedRepo) GetCommitMessage(ref string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetCommitMessage(ref)
+}
+
+func (repo *limitedRepo) GetCommitTime(ref string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetCommitTime(ref)
+}
+
+func (repo *limitedRepo) GetLastParent(ref string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.GetLastParent(ref)
+}
+
+func (repo *limitedRepo) GetCommitDetails(ref string) (*repository.CommitDetails, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return repo.Repo.GetCommitDetails(ref)
+}
+
+func (repo *limitedRepo) MergeBase(a, b string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.MergeBase(a, b)
+}
+
+func (repo *limitedRepo) IsAncestor(ancestor, descendant string) (bool, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return false, err
+	}
+	defer release()
+	return repo.Repo.IsAncestor(ancestor, descendant)
+}
+
+func (repo *limitedRepo) Diff(left, right string, diffArgs ...string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.Diff(left, right, diffArgs...)
+}
+
+func (repo *limitedRepo) Show(commit, path string) (string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return repo.Repo.Show(commit, path)
+}
+
+func (repo *limitedRepo) SwitchToRef(ref string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.SwitchToRef(ref)
+}
+
+func (repo *limitedRepo) ArchiveRef(ref, archive string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.ArchiveRef(ref, archive)
+}
+
+func (repo *limitedRepo) MergeRef(ref string, fastForward bool, messages ...string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.MergeRef(ref, fastForward, messages...)
+}
+
+func (repo *limitedRepo) MergeAndSignRef(ref string, fastForward bool, messages ...string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.MergeAndSignRef(ref, fastForward, messages...)
+}
+
+func (repo *limitedRepo) RebaseRef(ref string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.RebaseRef(ref)
+}
+
+func (repo *limitedRepo) RebaseAndSignRef(ref string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.RebaseAndSignRef(ref)
+}
+
+func (repo *limitedRepo) ListCommits(ref string) []string {
+	release, err := repo.acquire()
+	if err != nil {
+		return nil
+	}
+	defer release()
+	return repo.Repo.ListCommits(ref)
+}
+
+func (repo *limitedRepo) ListCommitsBetween(from, to string) ([]string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return repo.Repo.ListCommitsBetween(from, to)
+}
+
+func (repo *limitedRepo) GetNotes(notesRef, revision string) []repository.Note {
+	release, err := repo.acquire()
+	if err != nil {
+		return nil
+	}
+	defer release()
+	return repo.Repo.GetNotes(notesRef, revision)
+}
+
+func (repo *limitedRepo) GetAllNotes(notesRef string) (map[string][]repository.Note, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return repo.Repo.GetAllNotes(notesRef)
+}
+
+func (repo *limitedRepo) AppendNote(ref, revision string, note repository.Note) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.AppendNote(ref, revision, note)
+}
+
+func (repo *limitedRepo) ListNotedRevisions(notesRef string) []string {
+	release, err := repo.acquire()
+	if err != nil {
+		return nil
+	}
+	defer release()
+	return repo.Repo.ListNotedRevisions(notesRef)
+}
+
+func (repo *limitedRepo) PushNotes(remote, notesRefPattern string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.PushNotes(remote, notesRefPattern)
+}
+
+func (repo *limitedRepo) PullNotes(remote, notesRefPattern string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.PullNotes(remote, notesRefPattern)
+}
+
+func (repo *limitedRepo) PushNotesAndArchive(remote, notesRefPattern, archiveRefPattern string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.PushNotesAndArchive(remote, notesRefPattern, archiveRefPattern)
+}
+
+func (repo *limitedRepo) PullNotesAndArchive(remote, notesRefPattern, archiveRefPattern string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.PullNotesAndArchive(remote, notesRefPattern, archiveRefPattern)
+}
+
+func (repo *limitedRepo) MergeNotes(remote, notesRefPattern string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.MergeNotes(remote, notesRefPattern)
+}
+
+func (repo *limitedRepo) MergeArchives(remote, archiveRefPattern string) error {
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return repo.Repo.MergeArchives(remote, archiveRefPattern)
+}
+
+func (repo *limitedRepo) FetchAndReturnNewReviewHashes(remote, notesRefPattern, archiveRefPattern string) ([]string, error) {
+	release, err := repo.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return repo.Repo.FetchAndReturnNewReviewHashes(remote, notesRefPattern, archiveRefPattern)
+}
+
+func (repo *limitedRepo) listRefs() (map[string]string, error) {
+	indexable, ok := repo.Repo.(indexableRepo)
+	if !ok {
+		return nil, errIncrementalUnsupported
+	}
+	release, err := repo.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return indexable.listRefs()
+}
+
+func (repo *limitedRepo) changedNotes(from, to string) ([]string, error) {
+	indexable, ok := repo.Repo.(indexableRepo)
+	if !ok {
+		return nil, errIncrementalUnsupported
+	}
+	release, err := repo.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return indexable.changedNotes(from, to)
+}
+
+func (repo *limitedRepo) syncMirror(config *MirrorConfig) error {
+	mirroring, ok := repo.Repo.(mirroringRepo)
+	if !ok {
+		return errMirrorUnsupported
+	}
+	release, err := repo.acquire()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return mirroring.syncMirror(config)
+}
+
+// tokenBucket tracks the request budget of a single client.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiter applies a per-client token-bucket rate limit to HTTP handlers.
+//
+// Each client may make up to `burst` requests at once, and its budget refills at
+// `rate` requests per second. Clients are identified by their remote IP address.
+type RateLimiter struct {
+	rate  float64
+	burst float64
+	// TrustForwardedFor makes the limiter identify clients by the last address in the
+	// X-Forwarded-For header, which is the one added by the proxy in front of the server.
+	// This should only be set when every request comes through such a proxy.
+	TrustForwardedFor bool
+
+	mu        sync.Mutex
+	clients   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewRateLimiter constructs a new RateLimiter with the given refill rate and burst size.
+//
+// The rate must be positive.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		clients: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+func (limiter *RateLimiter) clientKey(r *http.Request) string {
+	if limiter.TrustForwardedFor {
+		// The earlier addresses are sent by the client, so they could be anything.
+		if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			addresses := strings.Split(values[len(values)-1], ",")
+			if address := strings.TrimSpace(addresses[len(addresses)-1]); address != "" {
+				return address
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// sweep drops the buckets of clients that have been idle long enough to be full again.
+//
+// The caller must hold the limiter's mutex.
+func (limiter *RateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimiterSweepInterval {
+		return
+	}
+	limiter.lastSweep = now
+	for key, bucket := range limiter.clients {
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*limiter.rate >= limiter.burst {
+			delete(limiter.clients, key)
+		}
+	}
+}
+
+// take consumes one token from the given client's bucket.
+//
+// If the bucket is empty, then it returns how long the client must wait for the next token.
+func (limiter *RateLimiter) take(key string) (ok bool, retryAfter time.Duration) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	now := limiter.now()
+	limiter.sweep(now)
+	bucket, found := limiter.clients[key]
+	if !found {
+		bucket = &tokenBucket{tokens: limiter.burst, last: now}
+		limiter.clients[key] = bucket
+	}
+	bucket.tokens = math.Min(limiter.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*limiter.rate)
+	bucket.last = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / limiter.rate * float64(time.Second))
+}
+
+// Limit wraps the given handler so that clients exceeding their rate limit receive
+// a "429 Too Many Requests" response with a Retry-After header.
+func (limiter *RateLimiter) Limit(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ok, retryAfter := limiter.take(limiter.clientKey(r))
+		if !ok {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			http.Error(w, "Too many requests", http.StatusTooManyRequests)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
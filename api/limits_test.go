@@ -0,0 +1,180 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/git-appraise/repository"
+)
+
+// callCounter records the maximum number of calls that were in flight at once.
+type callCounter struct {
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (counter *callCounter) call() {
+	counter.mu.Lock()
+	counter.inFlight++
+	if counter.inFlight > counter.maxInFlight {
+		counter.maxInFlight = counter.inFlight
+	}
+	counter.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	counter.mu.Lock()
+	counter.inFlight--
+	counter.mu.Unlock()
+}
+
+// slowRepo is a Repo whose GetRepoStateHash method takes a while to run.
+type slowRepo struct {
+	repository.Repo
+	path    string
+	counter *callCounter
+}
+
+func (repo *slowRepo) GetPath() string {
+	return repo.path
+}
+
+func (repo *slowRepo) GetRepoStateHash() (string, error) {
+	repo.counter.call()
+	return "", nil
+}
+
+func callConcurrently(repos []repository.Repo, callsPerRepo int) {
+	var wg sync.WaitGroup
+	for _, repo := range repos {
+		for i := 0; i < callsPerRepo; i++ {
+			wg.Add(1)
+			go func(repo repository.Repo) {
+				defer wg.Done()
+				repo.GetRepoStateHash()
+			}(repo)
+		}
+	}
+	wg.Wait()
+}
+
+func TestGitLimiter(t *testing.T) {
+	limiter := NewGitLimiter(3, 2)
+
+	perRepoCounter := &callCounter{}
+	callConcurrently([]repository.Repo{
+		limiter.Wrap(&slowRepo{path: "/first", counter: perRepoCounter}),
+	}, 10)
+	if perRepoCounter.maxInFlight > 2 {
+		t.Errorf("Per-repository limit exceeded: %d", perRepoCounter.maxInFlight)
+	}
+
+	globalCounter := &callCounter{}
+	callConcurrently([]repository.Repo{
+		limiter.Wrap(&slowRepo{path: "/first", counter: globalCounter}),
+		limiter.Wrap(&slowRepo{path: "/second", counter: globalCounter}),
+		limiter.Wrap(&slowRepo{path: "/third", counter: globalCounter}),
+	}, 10)
+	if globalCounter.maxInFlight > 3 {
+		t.Errorf("Global limit exceeded: %d", globalCounter.maxInFlight)
+	}
+}
+
+func TestGitLimiterCancelled(t *testing.T) {
+	limiter := NewGitLimiter(0, 0)
+	counter := &callCounter{}
+	repo := limiter.Wrap(&slowRepo{path: "/first", counter: counter})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := repoWithContext(ctx, repo).GetRepoStateHash()
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Err != context.Canceled {
+		t.Errorf("Unexpected error from a cancelled call: %v", err)
+	}
+	if counter.maxInFlight != 0 {
+		t.Errorf("Cancelled call reached the wrapped repo")
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := NewRateLimiter(2, 3)
+	limiter.now = func() time.Time { return now }
+	handler := limiter.Limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	get := func(remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/repos", nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	for i := 0; i < 3; i++ {
+		if w := get("10.0.0.1:1234"); w.Code != http.StatusOK {
+			t.Fatalf("Request %d within the burst was rejected: %d", i, w.Code)
+		}
+	}
+	w := get("10.0.0.1:5678")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("Request beyond the burst was not rejected: %d", w.Code)
+	}
+	if retryAfter := w.Header().Get("Retry-After"); retryAfter != "1" {
+		t.Errorf("Unexpected Retry-After header: %q", retryAfter)
+	}
+	if w := get("10.0.0.2:1234"); w.Code != http.StatusOK {
+		t.Errorf("A different client was rate limited: %d", w.Code)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if w := get("10.0.0.1:1234"); w.Code != http.StatusOK {
+		t.Errorf("Request after a refill was rejected: %d", w.Code)
+	}
+	if w := get("10.0.0.1:1234"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("Request after consuming the refill was not rejected: %d", w.Code)
+	}
+}
+
+func TestRateLimiterClientKey(t *testing.T) {
+	limiter := NewRateLimiter(1, 1)
+	limiter.TrustForwardedFor = true
+	for _, tc := range []struct {
+		forwardedFor []string
+		key          string
+	}{
+		{nil, "10.0.0.1"},
+		{[]string{"192.0.2.1"}, "192.0.2.1"},
+		// Clients can send any addresses they like, but the proxy appends the one it saw.
+		{[]string{"203.0.113.7, 192.0.2.1"}, "192.0.2.1"},
+		{[]string{"203.0.113.7", "198.51.100.2, 192.0.2.1"}, "192.0.2.1"},
+	} {
+		r := httptest.NewRequest("GET", "/repos", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		for _, value := range tc.forwardedFor {
+			r.Header.Add("X-Forwarded-For", value)
+		}
+		if key := limiter.clientKey(r); key != tc.key {
+			t.Errorf("Unexpected client key for %q: %q", tc.forwardedFor, key)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/google/git-appraise/review"
+)
+
+const (
+	// The maximum length of a reference to a review, which bounds the refs that are looked up.
+	maxReviewRefLength = 1024
+)
+
+// changeIDPattern matches the Change-Id trailers that Gerrit adds to commit messages, which are
+// carried over into the descriptions of the reviews requested for those commits.
+var changeIDPattern = regexp.MustCompile(`(?m)^Change-Id:\s*(I[0-9a-f]{40})\s*$`)
+
+// getChangeID returns the Change-Id given in the description of the given review, if any.
+func getChangeID(summary *review.Summary) string {
+	matches := changeIDPattern.FindAllStringSubmatch(summary.Request.Description, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	// As with commit message trailers, the last one wins.
+	return matches[len(matches)-1][1]
+}
+
+// findReview returns the revision of the first review matching the given predicate.
+//
+// Open reviews are preferred over closed ones, and within each, newer reviews over older ones.
+func (index *reviewIndex) findReview(match func(summary *review.Summary) bool) (string, bool) {
+	for _, reviews := range [][]review.Summary{index.openReviews, index.closedReviews} {
+		for i := range reviews {
+			if match(&reviews[i]) {
+				return reviews[i].Revision, true
+			}
+		}
+	}
+	return "", false
+}
+
+// listReviewCommits returns the commits in the given review, which are those after the review's
+// base commit, up to and including its head commit.
+func listReviewCommits(ctx context.Context, summary review.Summary) ([]string, error) {
+	summary.Repo = repoWithContext(ctx, summary.Repo)
+	r := &review.Review{Summary: &summary}
+	head, err := r.GetHeadCommit()
+	if err != nil {
+		return nil, err
+	}
+	base, err := getReviewBase(r)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := r.Repo.ListCommitsBetween(base, head)
+	if err != nil {
+		return nil, err
+	}
+	if len(commits) == 0 || commits[len(commits)-1] != head {
+		// The head is part of the review even if the base has caught up with it.
+		commits = append(commits, head)
+	}
+	return commits, nil
+}
+
+// getCommitReviews returns the map from each commit in a review to the review containing it,
+// building that map if this is the first lookup by commit since the index was built.
+//
+// Reviews whose commits cannot be read are left out, but if the given context is done before
+// the map is built, then an error is returned and the map is built again by the next lookup.
+func (index *reviewIndex) getCommitReviews(ctx context.Context) (map[string]string, error) {
+	select {
+	case index.commitReviewsLock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, &TimeoutError{Op: "waiting for the commits of each review", Err: ctx.Err()}
+	}
+	defer func() { <-index.commitReviewsLock }()
+	if index.commitReviews != nil {
+		return index.commitReviews, nil
+	}
+	commitReviews := make(map[string]string)
+	// The reviews are visited in the order that FindReview prefers them, so that the first review
+	// containing a commit is the one that it maps to.
+	for _, reviews := range [][]review.Summary{index.openReviews, index.closedReviews} {
+		for i := range reviews {
+			summary := &reviews[i]
+			commits, ok := index.commits.get(summary.Revision)
+			if !ok {
+				var err error
+				commits, err = listReviewCommits(ctx, *summary)
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil, checkContext(ctx, "listing the commits of each review", err)
+					}
+					continue
+				}
+				index.commits.add(summary.Revision, commits)
+			}
+			for _, commit := range commits {
+				if _, ok := commitReviews[commit]; !ok {
+					commitReviews[commit] = summary.Revision
+				}
+			}
+		}
+	}
+	index.commitReviews = commitReviews
+	return commitReviews, nil
+}
+
+// FindReview returns the revision of the review identified by the given reference, which is
+// checked against each of the following in turn:
+//
+//  1. The revision of the review.
+//  2. The alias of the review, which is the commit that a rebased review was moved to.
+//  3. The Change-Id given in the description of the review.
+//  4. The review's branch, by either its full ref name or its short name.
+//  5. A commit in the review, which may be abbreviated.
+//
+// When several reviews match, open reviews are preferred over closed ones, and newer reviews over older ones.
+// Finding the review containing a commit reads the commits of every review the first time that
+// it is done for each version of the index, so that lookup is much slower than the others.
+func (details *RepoDetails) FindReview(ctx context.Context, ref string) (string, error) {
+	if ref == "" || len(ref) > maxReviewRefLength {
+		return "", errUnknownReview
+	}
+	index, err := details.snapshot()
+	if err != nil {
+		return "", err
+	}
+	if _, ok := index.reviews[ref]; ok {
+		return ref, nil
+	}
+	if revision, ok := index.findReview(func(summary *review.Summary) bool {
+		return summary.Request.Alias == ref
+	}); ok {
+		return revision, nil
+	}
+	if strings.HasPrefix(ref, "I") {
+		if revision, ok := index.findReview(func(summary *review.Summary) bool {
+			return getChangeID(summary) == ref
+		}); ok {
+			return revision, nil
+		}
+	}
+	if revision, ok := index.findReview(func(summary *review.Summary) bool {
+		reviewRef := summary.Request.ReviewRef
+		return reviewRef != "" && (reviewRef == ref || reviewRef == "refs/heads/"+ref)
+	}); ok {
+		return revision, nil
+	}
+
+	// Only hashes are passed to git, so that the reference cannot be mistaken for an option.
+	if err := details.checkHash(ref); err != nil {
+		return "", errUnknownReview
+	}
+	commit, err := repoWithContext(ctx, details.Repo).GetCommitHash(ref)
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", checkContext(ctx, "finding commit "+ref, err)
+		}
+		return "", errUnknownReview
+	}
+	if revision, ok := index.findReview(func(summary *review.Summary) bool {
+		return summary.Revision == commit || summary.Request.Alias == commit
+	}); ok {
+		return revision, nil
+	}
+	commitReviews, err := index.getCommitReviews(ctx)
+	if err != nil {
+		return "", err
+	}
+	revision, ok := commitReviews[commit]
+	if !ok {
+		return "", errUnknownReview
+	}
+	return revision, nil
+}
+
+// ServeReviewRedirect redirects to the page of the review identified by the 'ref' URL parameter,
+// in the repository given by the 'repo' URL parameter.
+//
+// The reference is resolved by FindReview, so links to a review can be made from a commit in
+// it, its branch, or its Change-Id, without knowing the revision that the review was requested for.
+func (cache *RepoCache) ServeReviewRedirect(w http.ResponseWriter, r *http.Request) {
+	repoDetails, err := cache.getRepoDetails(r)
+	if err != nil {
+		serveError(w, err, lookupErrorCode(err))
+		return
+	}
+	revision, err := repoDetails.FindReview(r.Context(), strings.TrimSpace(r.URL.Query().Get("ref")))
+	if err != nil {
+		serveError(w, err, lookupErrorCode(err))
+		return
+	}
+	query := url.Values{"repo": {repoDetails.ID()}, "review": {revision}}
+	// The review that a branch or commit belongs to can change, so the redirect is not permanent.
+	http.Redirect(w, r, BasePath(r)+"/static/review.html#?"+query.Encode(), http.StatusTemporaryRedirect)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testChangeID = "I0123456789abcdef0123456789abcdef01234567"
+
+func TestFindReview(t *testing.T) {
+	dir := newTestGitDir(t)
+	head := runTestGit(t, dir, "rev-parse", "HEAD")
+	fix := addTestReviewOf(t, dir, "topic/fix", "src/fix.go",
+		`{"timestamp":"0000000100","reviewRef":"refs/heads/topic/fix","targetRef":"refs/heads/master","description":"Fix it\n\nChange-Id: `+testChangeID+`"}`)
+	runTestGit(t, dir, "checkout", "-q", "topic/fix")
+	runTestGit(t, dir, "commit", "-q", "--allow-empty", "-m", "Follow-up")
+	followUp := runTestGit(t, dir, "rev-parse", "HEAD")
+	runTestGit(t, dir, "checkout", "-q", "master")
+	// Two reviews of the same branch, the older of which is closed.
+	addTestReviewOf(t, dir, "shared-old", "old.txt",
+		`{"timestamp":"0000000050","reviewRef":"refs/heads/shared","description":"Old"}`)
+	shared := addTestReviewOf(t, dir, "shared", "shared.txt",
+		`{"timestamp":"0000000060","reviewRef":"refs/heads/shared","targetRef":"refs/heads/master","description":"New"}`)
+	runTestGit(t, dir, "checkout", "-q", "-b", "rebased", "master")
+	runTestGit(t, dir, "commit", "-q", "--allow-empty", "-m", "Rebased")
+	rebasedCommit := runTestGit(t, dir, "rev-parse", "HEAD")
+	runTestGit(t, dir, "checkout", "-q", "master")
+	rebased := addTestReviewOf(t, dir, "original", "original.txt",
+		`{"timestamp":"0000000070","reviewRef":"refs/heads/original","targetRef":"refs/heads/master","alias":"`+rebasedCommit+`","description":"Rebased"}`)
+
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoDetails := NewRepoDetails(repo)
+	for _, tc := range []struct {
+		ref, want string
+	}{
+		{fix, fix},
+		{fix[:10], fix},
+		{followUp, fix},
+		{followUp[:8], fix},
+		{testChangeID, fix},
+		{"topic/fix", fix},
+		{"refs/heads/topic/fix", fix},
+		{"shared", shared},
+		{rebasedCommit, rebased},
+		{head, ""},
+		{"missing", ""},
+		{"I0123456789abcdef0123456789abcdef0123456", ""},
+		{"--output=x", ""},
+		{"", ""},
+	} {
+		revision, err := repoDetails.FindReview(context.Background(), tc.ref)
+		if tc.want == "" {
+			if err != errUnknownReview {
+				t.Errorf("Unexpected review for %q: %q, %v", tc.ref, revision, err)
+			}
+		} else if err != nil || revision != tc.want {
+			t.Errorf("Unexpected review for %q: got %q, %v, want %q", tc.ref, revision, err, tc.want)
+		}
+	}
+
+	// The commits of the reviews are only read by the first lookup of a commit.
+	index, err := repoDetails.snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index.commitReviews[followUp] != fix || index.commitReviews[shared] != shared {
+		t.Errorf("Unexpected map from commits to reviews: %v", index.commitReviews)
+	}
+	index.commitReviews = map[string]string{followUp: shared}
+	if revision, err := repoDetails.FindReview(context.Background(), followUp); err != nil || revision != shared {
+		t.Errorf("The map from commits to reviews was not reused: %q, %v", revision, err)
+	}
+}
+
+func TestReviewRedirects(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	feature := runTestGit(t, dir, "rev-parse", "feature")
+	head := runTestGit(t, dir, "rev-parse", "HEAD")
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewRepoCache()
+	if err := cache.AddConfiguredRepo(repo, RepoConfig{Slug: "test"}).refresh(); err != nil {
+		t.Fatal(err)
+	}
+	v2 := http.StripPrefix("/api/v2", cache.V2Handler())
+
+	for _, tc := range []struct {
+		handler  http.Handler
+		url      string
+		code     int
+		location string
+	}{
+		{v2, "/api/v2/repos/test/reviews/feature", http.StatusTemporaryRedirect, feature},
+		{v2, "/api/v2/repos/test/reviews/" + feature[:7] + "/diff?lhs=" + head, http.StatusTemporaryRedirect, "../" + feature + "/diff?lhs=" + head},
+		{v2, "/api/v2/repos/test/reviews/" + feature, http.StatusOK, ""},
+		{v2, "/api/v2/repos/test/reviews/missing", http.StatusNotFound, ""},
+		{v2, "/api/v2/repos/test/lookup?ref=refs/heads/feature", http.StatusTemporaryRedirect, "reviews/" + feature},
+		{v2, "/api/v2/repos/test/lookup?ref=" + head, http.StatusNotFound, ""},
+		{http.HandlerFunc(cache.ServeReviewRedirect), "/review?repo=test&ref=feature", http.StatusTemporaryRedirect,
+			"/static/review.html#?repo=test&review=" + feature},
+		{http.HandlerFunc(cache.ServeReviewRedirect), "/review?repo=test&ref=missing", http.StatusNotFound, ""},
+		{http.HandlerFunc(cache.ServeReviewRedirect), "/review?repo=missing&ref=feature", http.StatusNotFound, ""},
+		{http.HandlerFunc(cache.ServeReviewRedirect), "/review?ref=feature", http.StatusBadRequest, ""},
+	} {
+		w := httptest.NewRecorder()
+		tc.handler.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+		if w.Code != tc.code || w.Header().Get("Location") != tc.location {
+			t.Errorf("Unexpected response for %s: %d %q %q", tc.url, w.Code, w.Header().Get("Location"), strings.TrimSpace(w.Body.String()))
+		}
+	}
+}
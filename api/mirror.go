@@ -0,0 +1,306 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/git-appraise-web/api/types"
+)
+
+const (
+	// How often a mirror is synced with its upstream when its configuration does not say.
+	defaultMirrorInterval = time.Minute
+
+	// The refs under which the upstream's notes are fetched before being merged.
+	upstreamNotesPrefix = "refs/notes/upstream/"
+)
+
+// The refspecs that fetch the reviews from an upstream, using the same refs as "git appraise pull".
+//
+// The archives keep the commits of past revisions of each review reachable. Fetching them is
+// enough for that, so they are not merged.
+var mirrorReviewRefspecs = []string{
+	"+refs/notes/devtools/*:" + upstreamNotesPrefix + "devtools/*",
+	"+refs/devtools/archives/*:refs/devtools/remoteArchives/upstream/*",
+}
+
+// The refspecs that are fetched from an upstream into a bare mirror when its configuration does not say.
+var defaultMirrorRefspecs = []string{"+refs/heads/*:refs/heads/*"}
+
+// The refspecs that are fetched from an upstream into a mirror with a worktree when its configuration
+// does not say. Git refuses to update the branch that is checked out, so the branches are fetched as
+// remote-tracking branches instead, which is where the reviews look for them when they are not local.
+var defaultWorktreeMirrorRefspecs = []string{"+refs/heads/*:refs/remotes/upstream/*"}
+
+// The first version of git with the "--no-write-fetch-head" flag.
+var noWriteFetchHeadVersion = [2]int{2, 29}
+
+var gitVersionOnce sync.Once
+var gitVersion [2]int
+
+// The identity under which the notes merged from an upstream are committed, as the server may have no identity of its own.
+var mirrorIdentity = []string{"-c", "user.name=git-appraise-web", "-c", "user.email=git-appraise-web@localhost"}
+
+// MirrorConfig describes the upstream repository that a repository mirrors.
+type MirrorConfig struct {
+	// Upstream is the URL of the upstream repository, or its path on the local filesystem.
+	Upstream string `json:"upstream"`
+	// Interval is how often to fetch from the upstream. This defaults to one minute.
+	Interval Duration `json:"interval,omitempty"`
+	// Refspecs lists the refs to fetch, in addition to the reviews. This defaults to every branch,
+	// which is fetched into the local branches of bare repositories, and into remote-tracking
+	// branches of the "upstream" remote otherwise.
+	Refspecs []string `json:"refspecs,omitempty"`
+}
+
+func (config *MirrorConfig) interval() time.Duration {
+	if config.Interval <= 0 {
+		return defaultMirrorInterval
+	}
+	return time.Duration(config.Interval)
+}
+
+func (config *MirrorConfig) refspecs(bare bool) []string {
+	if len(config.Refspecs) > 0 {
+		return config.Refspecs
+	}
+	if bare {
+		return defaultMirrorRefspecs
+	}
+	return defaultWorktreeMirrorRefspecs
+}
+
+// checkArgs returns an error if the upstream or any of the refspecs would be read by git as an option.
+func (config *MirrorConfig) checkArgs() error {
+	if strings.HasPrefix(config.Upstream, "-") {
+		return fmt.Errorf("The upstream %q must not start with \"-\"", config.Upstream)
+	}
+	for _, refspec := range config.Refspecs {
+		if strings.HasPrefix(refspec, "-") {
+			return fmt.Errorf("The refspec %q must not start with \"-\"", refspec)
+		}
+	}
+	return nil
+}
+
+// SyncStatus reports how a mirrored repository last synced with its upstream.
+type SyncStatus = types.SyncStatus
+
+// InitMirror creates an empty bare repository at the given path, to be filled in by mirroring
+// its upstream, unless something already exists there.
+func InitMirror(path string) error {
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return err
+	}
+	out, err := exec.Command("git", "init", "--quiet", "--bare", path).CombinedOutput()
+	if err != nil {
+		return errors.New(strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// runMirrorGit runs the given git command in the given repository, and returns its stdout.
+//
+// Errors include git's stderr, so that they say why the command failed.
+func runMirrorGit(ctx context.Context, dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return "", &TimeoutError{Op: "running git " + args[0], Err: ctxErr}
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", errors.New(msg)
+		}
+		return "", err
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// getGitVersion returns the major and minor version of the installed git, or zeros if that is unknown.
+func getGitVersion() [2]int {
+	gitVersionOnce.Do(func() {
+		out, err := exec.Command("git", "version").Output()
+		if err != nil {
+			return
+		}
+		// The output looks like "git version 2.39.5", possibly followed by a platform-specific suffix.
+		fields := strings.Fields(string(out))
+		if len(fields) < 3 {
+			return
+		}
+		parts := strings.SplitN(fields[2], ".", 3)
+		if len(parts) < 2 {
+			return
+		}
+		major, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return
+		}
+		minor, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return
+		}
+		gitVersion = [2]int{major, minor}
+	})
+	return gitVersion
+}
+
+// versionAtLeast reports whether the given version is the same as, or later than, the minimum.
+func versionAtLeast(version, minimum [2]int) bool {
+	return version[0] > minimum[0] || (version[0] == minimum[0] && version[1] >= minimum[1])
+}
+
+// syncMirror fetches the configured refs and the reviews from the upstream into the repository
+// in the given directory, and merges the upstream's reviews into the repository's own.
+//
+// Notes are merged with the "cat_sort_uniq" strategy, as "git appraise pull" does, so reviews
+// and comments made in either repository are kept.
+//
+// Versions of git that support it are told not to write FETCH_HEAD, which nothing reads.
+func syncMirror(ctx context.Context, dir string, config *MirrorConfig) error {
+	isBare, err := runMirrorGit(ctx, dir, "rev-parse", "--is-bare-repository")
+	if err != nil {
+		return err
+	}
+	fetchArgs := []string{"fetch", "--quiet"}
+	if versionAtLeast(getGitVersion(), noWriteFetchHeadVersion) {
+		fetchArgs = append(fetchArgs, "--no-write-fetch-head")
+	}
+	// The upstream and refspecs are checked not to look like options, but they are still kept apart
+	// from the options, so that git never reads them as such.
+	fetchArgs = append(fetchArgs, "--", config.Upstream)
+	fetchArgs = append(fetchArgs, config.refspecs(isBare == "true")...)
+	fetchArgs = append(fetchArgs, mirrorReviewRefspecs...)
+	if _, err := runMirrorGit(ctx, dir, fetchArgs...); err != nil {
+		return err
+	}
+	upstreamRefs, err := runMirrorGit(ctx, dir, "for-each-ref", "--format=%(refname)", upstreamNotesPrefix)
+	if err != nil {
+		return err
+	}
+	for _, upstreamRef := range strings.Fields(upstreamRefs) {
+		localRef := "refs/notes/" + strings.TrimPrefix(upstreamRef, upstreamNotesPrefix)
+		mergeArgs := append(append([]string{}, mirrorIdentity...),
+			"notes", "--ref", localRef, "merge", "--quiet", "-s", "cat_sort_uniq", upstreamRef)
+		if _, err := runMirrorGit(ctx, dir, mergeArgs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mirroringRepo is implemented by Repos that can be kept in sync with an upstream.
+type mirroringRepo interface {
+	// syncMirror fetches the configured refs and the reviews from the given upstream, and merges
+	// the upstream's reviews into the repository's own.
+	syncMirror(config *MirrorConfig) error
+}
+
+var errMirrorUnsupported = errors.New("The repository cannot be synced with an upstream")
+
+// syncMirror runs the sync under the repo's context.
+func (repo *gitRepo) syncMirror(config *MirrorConfig) error {
+	return syncMirror(repo.ctx, repo.Path, config)
+}
+
+// startMirroring starts keeping the repository in sync with the given upstream in the background,
+// unless that has already started.
+func (details *RepoDetails) startMirroring(config *MirrorConfig) {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	if details.mirror != nil || details.gone {
+		return
+	}
+	details.mirror = config
+	details.syncStatus = SyncStatus{Upstream: config.Upstream}
+	go details.mirrorInBackground(config)
+}
+
+// sync fetches the latest reviews from the repository's upstream, and records the outcome.
+func (details *RepoDetails) sync(config *MirrorConfig) error {
+	// A sync that is still running when the repository goes away is abandoned, rather than left to finish.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-details.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	err := errMirrorUnsupported
+	if mirroring, ok := repoWithContext(ctx, details.Repo).(mirroringRepo); ok {
+		err = mirroring.syncMirror(config)
+	}
+
+	details.mu.Lock()
+	now := time.Now()
+	details.syncStatus.LastAttempt = &now
+	details.syncStatus.Error = ""
+	if err != nil {
+		details.syncStatus.Error = err.Error()
+	} else {
+		details.syncStatus.LastSuccess = &now
+	}
+	details.mu.Unlock()
+	return err
+}
+
+func (details *RepoDetails) mirrorInBackground(config *MirrorConfig) {
+	ticker := time.NewTicker(config.interval())
+	defer ticker.Stop()
+	for {
+		if err := details.sync(config); err != nil {
+			log.Printf("Unable to sync %q with %q: %v", details.Repo.GetPath(), config.Upstream, err)
+		} else {
+			// Repositories that are refreshed in the background pick up the changes on their own,
+			// but this makes them visible right away for the ones that are not.
+			details.refresh()
+		}
+		select {
+		case <-details.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getSyncStatus reports how the repository last synced with its upstream, or nil if it is not a mirror.
+func (details *RepoDetails) getSyncStatus() *SyncStatus {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	if details.mirror == nil {
+		return nil
+	}
+	status := details.syncStatus
+	return &status
+}
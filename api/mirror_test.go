@@ -0,0 +1,168 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestMirror creates an upstream repository with a single review, and an empty mirror of it.
+// It returns the paths of both.
+func newTestMirror(t *testing.T) (string, string) {
+	t.Helper()
+	upstream := newTestGitDir(t)
+	addTestReview(t, upstream)
+	dir, err := ioutil.TempDir("", "git-appraise-web-mirror")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	mirror := filepath.Join(dir, "mirror.git")
+	if err := InitMirror(mirror); err != nil {
+		t.Fatal(err)
+	}
+	return upstream, mirror
+}
+
+func TestSyncMirror(t *testing.T) {
+	upstream, mirror := newTestMirror(t)
+	config := &MirrorConfig{Upstream: "file://" + upstream}
+	if err := syncMirror(context.Background(), mirror, config); err != nil {
+		t.Fatal(err)
+	}
+	feature := runTestGit(t, upstream, "rev-parse", "feature")
+	if head := runTestGit(t, mirror, "rev-parse", "feature"); head != feature {
+		t.Fatalf("The branches were not mirrored: %q", head)
+	}
+
+	// Notes added on either side are merged, rather than one side replacing the other.
+	runTestGit(t, mirror, "notes", "--ref", "refs/notes/devtools/reviews", "append", "-m", "mirror", feature)
+	runTestGit(t, upstream, "notes", "--ref", "refs/notes/devtools/reviews", "append", "-m", "upstream", feature)
+	if err := syncMirror(context.Background(), mirror, &MirrorConfig{Upstream: upstream}); err != nil {
+		t.Fatal(err)
+	}
+	notes := runTestGit(t, mirror, "notes", "--ref", "refs/notes/devtools/reviews", "show", feature)
+	if !strings.Contains(notes, "mirror") || !strings.Contains(notes, "upstream") {
+		t.Fatalf("Unexpected notes after syncing: %q", notes)
+	}
+
+	if err := InitMirror(mirror); err != nil {
+		t.Fatalf("Unable to reuse an existing mirror: %v", err)
+	}
+}
+
+func TestSyncMirrorOptionLikeUpstream(t *testing.T) {
+	upstream, mirror := newTestMirror(t)
+	marker := filepath.Join(filepath.Dir(mirror), "marker")
+	config := &MirrorConfig{
+		Upstream: "--upload-pack=touch " + marker + "; git-upload-pack:x",
+		Refspecs: []string{upstream},
+	}
+	if err := syncMirror(context.Background(), mirror, config); err == nil {
+		t.Fatal("Synced with an upstream that is not a repository")
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Fatalf("The upstream was read as an option: %v", err)
+	}
+}
+
+func TestSyncWorktreeMirror(t *testing.T) {
+	upstream, _ := newTestMirror(t)
+	mirror := newTestGitDir(t)
+	if err := syncMirror(context.Background(), mirror, &MirrorConfig{Upstream: upstream}); err != nil {
+		t.Fatal(err)
+	}
+	// The checked out branch cannot be overwritten, so the branches are fetched as remote-tracking branches.
+	feature := runTestGit(t, upstream, "rev-parse", "feature")
+	if head := runTestGit(t, mirror, "rev-parse", "refs/remotes/upstream/feature"); head != feature {
+		t.Fatalf("The branches were not mirrored: %q", head)
+	}
+	if notes := runTestGit(t, mirror, "notes", "--ref", "refs/notes/devtools/reviews", "show", feature); notes == "" {
+		t.Fatal("The reviews were not mirrored")
+	}
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	for _, tc := range []struct {
+		version [2]int
+		atLeast bool
+	}{
+		{[2]int{0, 0}, false},
+		{[2]int{1, 30}, false},
+		{[2]int{2, 28}, false},
+		{[2]int{2, 29}, true},
+		{[2]int{2, 39}, true},
+		{[2]int{3, 0}, true},
+	} {
+		if atLeast := versionAtLeast(tc.version, noWriteFetchHeadVersion); atLeast != tc.atLeast {
+			t.Errorf("Unexpected comparison of %v: %v", tc.version, atLeast)
+		}
+	}
+	if version := getGitVersion(); version[0] < 1 {
+		t.Errorf("Unable to read the version of git: %v", version)
+	}
+}
+
+func TestMirrorInBackground(t *testing.T) {
+	upstream, mirror := newTestMirror(t)
+	gitRepo, err := NewGitRepo(mirror)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The sync runs through the same wrappers as the rest of the server's git commands.
+	repo := NewCaches(1<<20, 1<<20, 1<<20).Wrap(NewGitLimiter(1, 1).Wrap(gitRepo))
+	cache := NewRepoCache()
+	repoDetails := cache.AddConfiguredRepo(repo, RepoConfig{
+		Path:   mirror,
+		Mirror: &MirrorConfig{Upstream: upstream, Interval: Duration(time.Hour)},
+	})
+	defer repoDetails.markGone()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		status := repoDetails.getSyncStatus()
+		if status.LastAttempt != nil {
+			if status.LastSuccess == nil || status.Error != "" {
+				t.Fatalf("Unexpected sync status: %+v", status)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("The mirror was not synced")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	summary, err := repoDetails.GetSummary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.OpenReviewCount != 1 || summary.Sync == nil || summary.Sync.Upstream != upstream {
+		t.Fatalf("Unexpected summary of the mirror: %+v", summary)
+	}
+
+	if err := repoDetails.sync(&MirrorConfig{Upstream: filepath.Join(upstream, "missing")}); err == nil {
+		t.Fatal("Syncing with a missing upstream succeeded")
+	}
+	if status := repoDetails.getSyncStatus(); status.Error == "" || status.LastSuccess == nil {
+		t.Fatalf("Unexpected sync status after a failure: %+v", status)
+	}
+}
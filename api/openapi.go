@@ -0,0 +1,466 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/git-appraise/review"
+)
+
+// jsonObject is a JSON object in the OpenAPI document.
+type jsonObject map[string]interface{}
+
+var timeType = reflect.TypeOf(time.Time{})
+var durationType = reflect.TypeOf(Duration(0))
+
+// schemaRegistry builds the schemas of the types that the API writes as JSON.
+//
+// Every named struct type is described once, under the "components" section of the document,
+// and referred to everywhere else. Types from different packages that share a name are told
+// apart by prefixing the name of their package.
+type schemaRegistry struct {
+	schemas jsonObject
+	types   map[string]reflect.Type
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: jsonObject{},
+		types:   make(map[string]reflect.Type),
+	}
+}
+
+// ref returns a reference to the schema of the given type, describing that type first if needed.
+func (registry *schemaRegistry) ref(t reflect.Type) jsonObject {
+	return jsonObject{"$ref": "#/components/schemas/" + registry.name(t)}
+}
+
+// name returns the name of the schema of the given named struct type, describing that type first if needed.
+func (registry *schemaRegistry) name(t reflect.Type) string {
+	name := t.Name()
+	if existing, ok := registry.types[name]; ok && existing != t {
+		pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+		name = strings.ToUpper(pkg[:1]) + pkg[1:] + name
+	}
+	if _, ok := registry.types[name]; !ok {
+		registry.types[name] = t
+		// The schema is filled in after it is registered, so that recursive types refer to themselves.
+		registry.schemas[name] = registry.structSchema(t)
+	}
+	return name
+}
+
+// schema returns the schema of the given type.
+func (registry *schemaRegistry) schema(t reflect.Type) jsonObject {
+	if t == timeType {
+		return jsonObject{"type": "string", "format": "date-time"}
+	}
+	if t == durationType {
+		return jsonObject{"type": "string", "example": "5m"}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return nullable(registry.schema(t.Elem()))
+	case reflect.Struct:
+		if t.Name() == "" {
+			return registry.structSchema(t)
+		}
+		return registry.ref(t)
+	case reflect.Slice:
+		// A nil slice is written as null.
+		return nullable(jsonObject{"type": "array", "items": registry.schema(t.Elem())})
+	case reflect.Array:
+		return jsonObject{"type": "array", "items": registry.schema(t.Elem())}
+	case reflect.Map:
+		return nullable(jsonObject{"type": "object", "additionalProperties": registry.schema(t.Elem())})
+	case reflect.String:
+		return jsonObject{"type": "string"}
+	case reflect.Bool:
+		return jsonObject{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return jsonObject{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return jsonObject{"type": "number"}
+	}
+	return jsonObject{}
+}
+
+// nullable marks the given schema as also allowing null.
+//
+// The siblings of a reference are ignored, so a reference is wrapped in an allOf instead.
+func nullable(schema jsonObject) jsonObject {
+	if _, ok := schema["$ref"]; ok {
+		return jsonObject{"allOf": []jsonObject{schema}, "nullable": true}
+	}
+	schema["nullable"] = true
+	return schema
+}
+
+// structSchema describes the JSON encoding of the given struct type, following the rules of encoding/json.
+func (registry *schemaRegistry) structSchema(t reflect.Type) jsonObject {
+	properties := jsonObject{}
+	var required []string
+	registry.addFields(t, properties, &required, true)
+	schema := jsonObject{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+	return schema
+}
+
+// addFields adds the properties of the given struct type's fields to the given properties.
+//
+// The properties that are always present are added to the required list, unless the fields
+// belong to an embedded pointer that may be nil, and so are not always present.
+func (registry *schemaRegistry) addFields(t reflect.Type, properties jsonObject, required *[]string, present bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options := tag, ""
+		if comma := strings.Index(tag, ","); comma >= 0 {
+			name, options = tag[:comma], tag[comma+1:]
+		}
+		fieldType := field.Type
+		if field.Anonymous && name == "" {
+			// The fields of embedded structs are encoded as if they were fields of the outer struct.
+			embeddedPresent := present
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType, embeddedPresent = fieldType.Elem(), false
+			}
+			if fieldType.Kind() == reflect.Struct {
+				registry.addFields(fieldType, properties, required, embeddedPresent)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = registry.schema(fieldType)
+		if present && !strings.Contains(strings.ToLower(options), "omitempty") && fieldType.Kind() != reflect.Ptr {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// sortedKeys returns the names of the given orders, for use as an enum.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func parameter(name, in, description string, schema jsonObject) jsonObject {
+	param := jsonObject{"name": name, "in": in, "description": description, "schema": schema}
+	if in == "path" {
+		param["required"] = true
+	}
+	return param
+}
+
+func stringSchema() jsonObject {
+	return jsonObject{"type": "string"}
+}
+
+func enumSchema(values ...string) jsonObject {
+	return jsonObject{"type": "string", "enum": values}
+}
+
+func jsonContent(schema jsonObject) jsonObject {
+	return jsonObject{"application/json": jsonObject{"schema": schema}}
+}
+
+func operation(id, summary string, params []jsonObject, responses jsonObject) jsonObject {
+	return jsonObject{"get": newOperation(id, summary, params, responses)}
+}
+
+func newOperation(id, summary string, params []jsonObject, responses jsonObject) jsonObject {
+	op := jsonObject{"operationId": id, "summary": summary, "responses": responses}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	return op
+}
+
+// adminOperation describes an operation served on the admin address, which requires the admin token.
+func adminOperation(id, summary string, params []jsonObject, responses jsonObject) jsonObject {
+	op := newOperation(id, summary, params, responses)
+	op["security"] = []jsonObject{{"adminToken": []string{}}}
+	responses["401"] = jsonObject{"description": "The request does not carry the admin token.",
+		"content": jsonObject{"text/plain": jsonObject{"schema": stringSchema()}}}
+	return op
+}
+
+// newOpenAPIDocument returns the OpenAPI 3 document describing every endpoint of the API
+// served under the given base path.
+func newOpenAPIDocument(basePath string) jsonObject {
+	registry := newSchemaRegistry()
+	ref := func(v interface{}) jsonObject {
+		return registry.ref(reflect.TypeOf(v))
+	}
+	arrayOf := func(v interface{}) jsonObject {
+		return jsonObject{"type": "array", "items": ref(v)}
+	}
+
+	repoParam := parameter("repo", "query", "The slug of the repository.", stringSchema())
+	repoParam["required"] = true
+	reviewParam := parameter("review", "query", "The revision of the review.", stringSchema())
+	reviewParam["required"] = true
+	repoPathParam := parameter("repo", "path", "The slug of the repository.", stringSchema())
+	reviewPathParam := parameter("review", "path",
+		"The revision of the review. Any other reference to the review, such as a commit in it, is redirected to its revision.", stringSchema())
+	refParam := parameter("ref", "query",
+		"A commit in the review, the review's branch, or its alias or Change-Id.", stringSchema())
+	refParam["required"] = true
+	repoSortParam := parameter("sort", "query", "The order of the repositories.", enumSchema(sortedKeys(repoListOrders)...))
+	diffParams := []jsonObject{
+		parameter("lhs", "query", "The commit on the left-hand side of the diff. Defaults to the review's base commit.", stringSchema()),
+		parameter("rhs", "query", "The commit on the right-hand side of the diff. Defaults to the review's head commit.", stringSchema()),
+	}
+	reviewListParams := []jsonObject{
+		parameter("sort", "query", "The order of the reviews.", enumSchema(sortedKeys(reviewListOrders)...)),
+		parameter("page_size", "query", "The maximum number of reviews in the page.",
+			jsonObject{"type": "integer", "minimum": 1, "maximum": maxReviewPageSize, "default": defaultReviewPageSize}),
+		parameter("page", "query", "The nextPageToken of the previous page.", stringSchema()),
+		parameter("requester", "query", "Only list the reviews requested by the given user.", stringSchema()),
+		parameter("reviewer", "query", "Only list the reviews assigned to the given user.", stringSchema()),
+		parameter("target", "query", "Only list the reviews targeting the given ref.", stringSchema()),
+		parameter("state", "query", "Only list the reviews in the given state.",
+			enumSchema(reviewStateAccepted, reviewStatePending, reviewStateRejected)),
+		parameter("path", "query", "Only list the reviews that change the file with the given path, or a file beneath the directory with that path.", stringSchema()),
+		parameter("description", "query", "Only list the reviews whose description contains the given text.", stringSchema()),
+	}
+	for _, param := range []struct{ name, description string }{
+		{"created_after", "Only list the reviews first requested at or after the given time."},
+		{"created_before", "Only list the reviews first requested before the given time."},
+		{"updated_after", "Only list the reviews last requested or commented on at or after the given time."},
+		{"updated_before", "Only list the reviews last requested or commented on before the given time."},
+	} {
+		reviewListParams = append(reviewListParams, parameter(param.name, "query",
+			param.description+" This is a date (2006-01-02) or an RFC 3339 timestamp.", stringSchema()))
+	}
+
+	textError := jsonObject{"description": "The request failed.", "content": jsonObject{"text/plain": jsonObject{"schema": stringSchema()}}}
+	v1Responses := func(description string, schema jsonObject) jsonObject {
+		return jsonObject{
+			"200":     jsonObject{"description": description, "content": jsonContent(schema)},
+			"default": textError,
+		}
+	}
+	v2Error := func(description string) jsonObject {
+		return jsonObject{"description": description, "content": jsonContent(ref(ErrorResponse{}))}
+	}
+	v2Responses := func(description string, schema jsonObject) jsonObject {
+		return jsonObject{
+			"200":     jsonObject{"description": description, "content": jsonContent(schema)},
+			"400":     v2Error("The parameters are invalid."),
+			"404":     v2Error("The repository or review does not exist."),
+			"410":     v2Error("The repository has been removed."),
+			"504":     v2Error("The request timed out."),
+			"default": v2Error("The request failed."),
+		}
+	}
+	reviewRedirect := jsonObject{"description": "A redirect to the review.",
+		"headers": jsonObject{"Location": jsonObject{"description": "The path of the review.", "schema": stringSchema()}}}
+	v2ReviewResponses := func(description string, schema jsonObject) jsonObject {
+		responses := v2Responses(description, schema)
+		responses["307"] = reviewRedirect
+		return responses
+	}
+	fileResponse := jsonObject{"description": "The contents of the file.",
+		"content": jsonObject{"application/octet-stream": jsonObject{"schema": jsonObject{"type": "string", "format": "binary"}}}}
+
+	listReviewsV1 := append([]jsonObject{repoParam}, reviewListParams...)
+	listReviewsV2 := append([]jsonObject{repoPathParam,
+		parameter("status", "query", "Whether to list the open or the closed reviews.", enumSchema(reviewStatusOpen, reviewStatusClosed)),
+	}, reviewListParams...)
+	graphQLResponse := jsonObject{"description": "The result of the query, with any errors listed alongside the data.",
+		"content": jsonContent(jsonObject{"type": "object", "properties": jsonObject{
+			"data":   jsonObject{"type": "object"},
+			"errors": jsonObject{"type": "array", "items": jsonObject{"type": "object"}},
+		}})}
+	graphQL := operation("graphQLQuery", "Runs a GraphQL query over the repositories, reviews, and comments.", []jsonObject{
+		parameter("query", "query", "The GraphQL query.", stringSchema()),
+		parameter("operationName", "query", "The operation to run, if the query has several.", stringSchema()),
+		parameter("variables", "query", "The values of the query's variables, as a JSON object.", stringSchema()),
+	}, jsonObject{"200": graphQLResponse, "default": textError})
+	graphQL["post"] = jsonObject{
+		"operationId": "graphQLQueryPost",
+		"summary":     "Runs a GraphQL query over the repositories, reviews, and comments.",
+		"requestBody": jsonObject{"required": true, "content": jsonContent(jsonObject{"type": "object",
+			"required": []string{"query"},
+			"properties": jsonObject{
+				"query":         stringSchema(),
+				"operationName": stringSchema(),
+				"variables":     jsonObject{"type": "object"},
+			}})},
+		"responses": jsonObject{"200": graphQLResponse, "default": textError},
+	}
+	pageRedirect := jsonObject{"description": "A redirect to the page of the review.",
+		"headers": jsonObject{"Location": jsonObject{"description": "The URL of the review's page.", "schema": stringSchema()}}}
+	textResponse := func(description string) jsonObject {
+		return jsonObject{"description": description, "content": jsonObject{"text/plain": jsonObject{"schema": stringSchema()}}}
+	}
+	persistParam := parameter("persist", "query",
+		"Whether to also write the change to the configuration file. If that fails, then the change is not made.",
+		jsonObject{"type": "boolean", "default": false})
+	adminServers := []jsonObject{{
+		"url":         "{adminAddr}",
+		"description": "The admin endpoints are only served on the address given by --admin_addr, when --admin_token is set.",
+		"variables":   jsonObject{"adminAddr": jsonObject{"default": "http://localhost:6060"}},
+	}}
+	adminRepos := jsonObject{
+		"servers": adminServers,
+		"post": adminOperation("adminAddRepo", "Adds a repository, or replaces the metadata of one that is already served.",
+			[]jsonObject{persistParam}, jsonObject{
+				"200":     jsonObject{"description": "The repository was already served.", "content": jsonContent(ref(RepoListItem{}))},
+				"201":     jsonObject{"description": "The repository was added.", "content": jsonContent(ref(RepoListItem{}))},
+				"400":     textResponse("The repository configuration is invalid, or the repository cannot be opened."),
+				"409":     textResponse("The slug is used by another repository."),
+				"default": textError,
+			}),
+		"delete": adminOperation("adminRemoveRepo", "Stops serving a repository.",
+			[]jsonObject{repoParam, persistParam}, jsonObject{
+				"204":     jsonObject{"description": "The repository was removed."},
+				"404":     textResponse("The repository does not exist."),
+				"default": textError,
+			}),
+	}
+	adminRepos["post"].(jsonObject)["requestBody"] = jsonObject{"required": true, "content": jsonContent(ref(RepoConfig{}))}
+	adminReindex := jsonObject{
+		"servers": adminServers,
+		"post": adminOperation("adminReindexRepo", "Reads every review in a repository again, discarding what was read before.",
+			[]jsonObject{repoParam}, jsonObject{
+				"200":     jsonObject{"description": "The status of the reindexed repository.", "content": jsonContent(ref(RepoStatus{}))},
+				"404":     textResponse("The repository does not exist."),
+				"default": textError,
+			}),
+	}
+	paths := jsonObject{
+		"/healthz": operation("healthz", "Checks that the server is running.", nil, jsonObject{
+			"200": jsonObject{"description": "The server is running.", "content": jsonObject{"text/plain": jsonObject{"schema": stringSchema()}}},
+		}),
+		"/readyz": operation("readyz", "Checks that every repository has been read.", nil, jsonObject{
+			"200": jsonObject{"description": "Every repository has been read.", "content": jsonContent(ref(ReadinessReport{}))},
+			"503": jsonObject{"description": "The repositories are still being read, or there are none to serve.", "content": jsonContent(ref(ReadinessReport{}))},
+		}),
+		"/review": operation("lookupReviewPage", "Redirects to the page of the review identified by a commit, branch, alias, or Change-Id.",
+			[]jsonObject{repoParam, refParam}, jsonObject{"307": pageRedirect, "default": textError}),
+		"/api/repos": operation("listRepos", "Lists the repositories.",
+			[]jsonObject{repoSortParam}, v1Responses("The repositories.", arrayOf(RepoListItem{}))),
+		"/api/scan_progress": operation("getScanProgress", "Reports the progress of the scan for repositories.",
+			nil, v1Responses("The progress of the scan.", ref(ScanProgress{}))),
+		"/api/repo_summary": operation("getRepoSummary", "Summarizes a repository.",
+			[]jsonObject{repoParam}, v1Responses("The summary of the repository.", ref(RepoSummary{}))),
+		"/api/repo_contents": operation("getRepoContents", "Reads a file at a commit.", []jsonObject{
+			repoParam,
+			parameter("commit", "query", "The commit to read the file at.", stringSchema()),
+			parameter("file", "query", "The path of the file.", stringSchema()),
+		}, jsonObject{"200": fileResponse, "default": textError}),
+		"/api/open_reviews": operation("listOpenReviews", "Lists a page of the open reviews in a repository.",
+			listReviewsV1, v1Responses("A page of reviews.", ref(ReviewListResponse{}))),
+		"/api/closed_reviews": operation("listClosedReviews", "Lists a page of the closed reviews in a repository.",
+			listReviewsV1, v1Responses("A page of reviews.", ref(ReviewListResponse{}))),
+		"/api/review_details": operation("getReviewDetails", "Reads a review.",
+			[]jsonObject{repoParam, reviewParam}, v1Responses("The review.", ref(review.Review{}))),
+		"/api/review_diff": operation("getReviewDiff", "Reads the diff of a review.",
+			append([]jsonObject{repoParam, reviewParam}, diffParams...), v1Responses("The diff.", ref(DiffSummary{}))),
+		"/api/graphql": graphQL,
+
+		"/api/v2/openapi.json": operation("getOpenAPIDocument", "Describes the API.", nil, jsonObject{
+			"200": jsonObject{"description": "This document.", "content": jsonContent(jsonObject{"type": "object"})},
+		}),
+		"/api/v2/repos": operation("v2ListRepos", "Lists the repositories.",
+			[]jsonObject{repoSortParam}, v2Responses("The repositories.", arrayOf(RepoListItem{}))),
+		"/api/v2/scan": operation("v2GetScanProgress", "Reports the progress of the scan for repositories.",
+			nil, v2Responses("The progress of the scan.", ref(ScanProgress{}))),
+		"/api/v2/repos/{repo}": operation("v2GetRepo", "Summarizes a repository.",
+			[]jsonObject{repoPathParam}, v2Responses("The summary of the repository.", ref(RepoSummary{}))),
+		"/api/v2/repos/{repo}/reviews": operation("v2ListReviews", "Lists a page of the reviews in a repository.",
+			listReviewsV2, v2Responses("A page of reviews.", ref(ReviewListResponse{}))),
+		"/api/v2/repos/{repo}/reviews/{review}": operation("v2GetReview", "Reads a review.",
+			[]jsonObject{repoPathParam, reviewPathParam}, v2ReviewResponses("The review.", ref(review.Review{}))),
+		"/api/v2/repos/{repo}/lookup": operation("v2LookupReview", "Redirects to the review identified by a commit, branch, alias, or Change-Id.", []jsonObject{
+			repoPathParam,
+			refParam,
+		}, jsonObject{
+			"307":     reviewRedirect,
+			"400":     v2Error("The repository is invalid."),
+			"404":     v2Error("The repository or review does not exist."),
+			"410":     v2Error("The repository has been removed."),
+			"504":     v2Error("The request timed out."),
+			"default": v2Error("The request failed."),
+		}),
+		"/api/v2/repos/{repo}/reviews/{review}/diff": operation("v2GetReviewDiff", "Reads the diff of a review.",
+			append([]jsonObject{repoPathParam, reviewPathParam}, diffParams...), v2ReviewResponses("The diff.", ref(DiffSummary{}))),
+		"/api/v2/repos/{repo}/commits/{commit}/files/{path}": operation("v2GetFile", "Reads a file at a commit.", []jsonObject{
+			repoPathParam,
+			parameter("commit", "path", "The commit to read the file at.", stringSchema()),
+			parameter("path", "path", "The path of the file, which may include slashes.", stringSchema()),
+		}, jsonObject{
+			"200":     fileResponse,
+			"400":     v2Error("The commit is invalid."),
+			"404":     v2Error("The repository or file does not exist."),
+			"default": v2Error("The request failed."),
+		}),
+
+		"/admin/repos":   adminRepos,
+		"/admin/reindex": adminReindex,
+	}
+
+	if basePath == "" {
+		basePath = "/"
+	}
+	return jsonObject{
+		"openapi": "3.0.3",
+		"info": jsonObject{
+			"title":   "git-appraise web API",
+			"version": "2",
+			"description": "Read-only access to the code reviews stored in git repositories by git-appraise. " +
+				"The endpoints under /api/v2 supersede the original endpoints under /api, which are kept for existing clients. " +
+				"The endpoints under /admin change which repositories are served, and are only served on the admin address.",
+		},
+		"servers": []jsonObject{{"url": basePath}},
+		"paths":   paths,
+		"components": jsonObject{
+			"schemas": registry.schemas,
+			"securitySchemes": jsonObject{
+				"adminToken": jsonObject{"type": "http", "scheme": "bearer", "description": "The token given by --admin_token."},
+			},
+		},
+	}
+}
+
+// serveOpenAPIDocument writes the OpenAPI document describing the API to the given writer.
+func serveOpenAPIDocument(w http.ResponseWriter, r *http.Request) {
+	serveV2JSON(newOpenAPIDocument(BasePath(r)), w)
+}
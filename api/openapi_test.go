@@ -0,0 +1,84 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOpenAPISchemas(t *testing.T) {
+	schemas := newOpenAPIDocument("/reviews")["components"].(jsonObject)["schemas"].(jsonObject)
+	// The reports written by CI systems and by analyzers are different types with the same name.
+	for _, name := range []string{"Report", "AnalysesReport"} {
+		if _, ok := schemas[name]; !ok {
+			t.Errorf("The schema %q is not described", name)
+		}
+	}
+
+	// The fields of embedded structs are flattened, and are only required if they are always present.
+	item := schemas["RepoListItem"].(jsonObject)
+	properties := item["properties"].(jsonObject)
+	for _, name := range []string{"id", "name", "tags", "openReviewCount"} {
+		if _, ok := properties[name]; !ok {
+			t.Errorf("The property %q of RepoListItem is not described", name)
+		}
+	}
+	if required := item["required"]; !reflect.DeepEqual(required, []string{"id", "path"}) {
+		t.Errorf("Unexpected required properties of RepoListItem: %v", required)
+	}
+	if _, ok := schemas["Summary"].(jsonObject)["properties"].(jsonObject)["Repo"]; ok {
+		t.Error("A field that is not encoded is described")
+	}
+
+	// Pointers, slices, and maps may be written as null.
+	for _, name := range []string{"tags", "latestActivity"} {
+		if nullable := properties[name].(jsonObject)["nullable"]; nullable != true {
+			t.Errorf("The property %q of RepoListItem is not nullable", name)
+		}
+	}
+	details := schemas["CommitOverview"].(jsonObject)["properties"].(jsonObject)["details"].(jsonObject)
+	if _, ok := details["allOf"]; !ok || details["nullable"] != true {
+		t.Errorf("Unexpected schema of a pointer to a struct: %v", details)
+	}
+}
+
+func TestOpenAPIPaths(t *testing.T) {
+	paths := newOpenAPIDocument("/")["paths"].(jsonObject)
+	for _, tc := range []struct{ path, method string }{
+		{"/review", "get"},
+		{"/admin/repos", "post"},
+		{"/admin/repos", "delete"},
+		{"/admin/reindex", "post"},
+	} {
+		pathItem, ok := paths[tc.path].(jsonObject)
+		if !ok {
+			t.Errorf("The path %q is not described", tc.path)
+			continue
+		}
+		if _, ok := pathItem[tc.method]; !ok {
+			t.Errorf("The %s operation of %q is not described", tc.method, tc.path)
+		}
+	}
+	adminRepos := paths["/admin/repos"].(jsonObject)
+	if _, ok := adminRepos["servers"]; !ok {
+		t.Error("The admin endpoints are not described as served on the admin address")
+	}
+	if _, ok := adminRepos["post"].(jsonObject)["security"]; !ok {
+		t.Error("The admin endpoints are not described as requiring the admin token")
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/google/git-appraise/review"
+)
+
+const (
+	// The number of reviews in a page when the client does not choose a page size.
+	defaultReviewPageSize = 100
+	// The largest page size that a client can choose.
+	maxReviewPageSize = 1000
+	// The order that reviews are listed in by default, which is also the order kept in the index.
+	defaultReviewOrder = "created"
+)
+
+// reviewSortKey is the position of a review in an order of reviews.
+//
+// Reviews are ordered by their primary keys, in ascending order, then by their times, newest
+// first, and finally by their revisions. Since no two reviews share a revision, every review has
+// a distinct position, which lets a page token identify where a page ends even after reviews
+// have been added to or removed from the list.
+type reviewSortKey struct {
+	// Order is the name of the order, which is only set in page tokens.
+	Order    string `json:"o"`
+	Primary  string `json:"p,omitempty"`
+	Time     int64  `json:"t"`
+	Revision string `json:"r"`
+}
+
+// before reports whether the review at the key comes before the review at the other key.
+func (key reviewSortKey) before(other reviewSortKey) bool {
+	if key.Primary != other.Primary {
+		return key.Primary < other.Primary
+	}
+	if key.Time != other.Time {
+		return key.Time > other.Time
+	}
+	return key.Revision < other.Revision
+}
+
+// reviewListOrders maps the name of each order that reviews can be listed in to a function
+// that returns the position of a review in that order.
+var reviewListOrders = map[string]func(summary *review.Summary) reviewSortKey{
+	// The most recently requested reviews come first.
+	"created": func(summary *review.Summary) reviewSortKey {
+		return reviewSortKey{Time: getCreationTime(summary).Unix(), Revision: summary.Revision}
+	},
+	// The reviews with the most recent requests or comments come first.
+	"updated": func(summary *review.Summary) reviewSortKey {
+		return reviewSortKey{Time: getLatestActivity(summary).Unix(), Revision: summary.Revision}
+	},
+	// The reviews are grouped by requester, with each requester's most recent reviews first.
+	"requester": func(summary *review.Summary) reviewSortKey {
+		return reviewSortKey{Primary: summary.Request.Requester, Time: getCreationTime(summary).Unix(), Revision: summary.Revision}
+	},
+}
+
+// sortReviews sorts the given reviews into the order with the given name.
+func sortReviews(reviews []review.Summary, order string) {
+	keyOf := reviewListOrders[order]
+	keys := make(map[string]reviewSortKey, len(reviews))
+	for i := range reviews {
+		keys[reviews[i].Revision] = keyOf(&reviews[i])
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		return keys[reviews[i].Revision].before(keys[reviews[j].Revision])
+	})
+}
+
+// encodePageToken returns the opaque page token for the page that follows the review at the given key in the given order.
+func encodePageToken(order string, key reviewSortKey) string {
+	key.Order = order
+	contents, err := json.Marshal(key)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(contents)
+}
+
+// decodePageToken reads the key of the review that precedes the page with the given token.
+func decodePageToken(token string) (*reviewSortKey, error) {
+	contents, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("Invalid page token")
+	}
+	var key reviewSortKey
+	if err := json.Unmarshal(contents, &key); err != nil || key.Revision == "" {
+		return nil, errors.New("Invalid page token")
+	}
+	return &key, nil
+}
+
+// decodePageTokenFor reads the key of the review that precedes the page with the given token,
+// which must have been returned by a list in the given order.
+func decodePageTokenFor(token, order string) (*reviewSortKey, error) {
+	after, err := decodePageToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if after.Order != order {
+		return nil, errors.New("The page token is for a different sort order")
+	}
+	return after, nil
+}
+
+// ReviewListQuery selects a page of a list of reviews.
+type ReviewListQuery struct {
+	// Order is the name of the order to list the reviews in, which is "created", "updated", or "requester".
+	Order string
+	// PageSize is the maximum number of reviews in the page.
+	PageSize int
+	// PageToken is the token of the page to return, as returned in the previous page of the
+	// same list. The first page is returned if this is empty.
+	PageToken string
+	// Filter selects the reviews to list. A nil filter matches every review.
+	Filter *ReviewFilter
+}
+
+// parseReviewListQuery reads the page of reviews selected by the URL parameters of a request to list reviews.
+//
+// The parameters are "sort", "page_size", and "page", along with those read by parseReviewFilter.
+func parseReviewListQuery(query url.Values) (*ReviewListQuery, error) {
+	filter, err := parseReviewFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	listQuery := &ReviewListQuery{
+		Order:     query.Get("sort"),
+		PageSize:  defaultReviewPageSize,
+		PageToken: query.Get("page"),
+		Filter:    filter,
+	}
+	if listQuery.Order == "" {
+		listQuery.Order = defaultReviewOrder
+	}
+	if _, ok := reviewListOrders[listQuery.Order]; !ok {
+		return nil, errors.New("Invalid sort parameter")
+	}
+	if listQuery.PageToken != "" {
+		// The token is checked here, so that an invalid one is rejected along with the other parameters.
+		if _, err := decodePageTokenFor(listQuery.PageToken, listQuery.Order); err != nil {
+			return nil, err
+		}
+	}
+	if pageSize := query.Get("page_size"); pageSize != "" {
+		listQuery.PageSize, err = strconv.Atoi(pageSize)
+		if err != nil || listQuery.PageSize < 1 || listQuery.PageSize > maxReviewPageSize {
+			return nil, errors.New("Invalid page_size parameter")
+		}
+	}
+	return listQuery, nil
+}
+
+// listReviews returns the page of the given reviews that is selected by the given query.
+//
+// The given reviews must be in the default order; they are sorted again (in a copy) if the query
+// uses another order. Reviews that match the query's filter are read starting from the one after
+// the review identified by the page token, so that a page is unaffected by changes that are made
+// to the list before that review. The paths touched by the reviews are cached in the given cache, if any.
+func listReviews(ctx context.Context, reviews []review.Summary, touched *revisionLists, query *ReviewListQuery) (*ReviewListResponse, error) {
+	order, pageSize := query.Order, query.PageSize
+	if order == "" {
+		order = defaultReviewOrder
+	}
+	keyOf, ok := reviewListOrders[order]
+	if !ok {
+		return nil, errors.New("Invalid sort order")
+	}
+	if pageSize <= 0 {
+		pageSize = defaultReviewPageSize
+	}
+	if order != defaultReviewOrder {
+		reviews = append([]review.Summary(nil), reviews...)
+		sortReviews(reviews, order)
+	}
+	start := 0
+	if query.PageToken != "" {
+		after, err := decodePageTokenFor(query.PageToken, order)
+		if err != nil {
+			return nil, err
+		}
+		start = sort.Search(len(reviews), func(i int) bool {
+			return after.before(keyOf(&reviews[i]))
+		})
+	}
+
+	filter := query.Filter
+	if filter != nil && filter.isEmpty() {
+		filter = nil
+	}
+	response := &ReviewListResponse{Items: []review.Summary{}}
+	for i := start; i < len(reviews); i++ {
+		summary := &reviews[i]
+		if filter != nil {
+			matched, err := filter.matchesWithPaths(ctx, touched, summary)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+		}
+		if len(response.Items) == pageSize {
+			// There is at least one more matching review, so the list continues on another page.
+			response.NextPageToken = encodePageToken(order, keyOf(&response.Items[pageSize-1]))
+			break
+		}
+		response.Items = append(response.Items, *summary)
+	}
+	return response, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/git-appraise/review"
+	"github.com/google/git-appraise/review/comment"
+	"github.com/google/git-appraise/review/request"
+)
+
+// newTestSummary returns a review with the given revision, requester, and request time.
+func newTestSummary(revision, requester string, timestamp int) review.Summary {
+	return review.Summary{
+		Revision: revision,
+		Request: request.Request{
+			Timestamp: fmt.Sprintf("%010d", timestamp),
+			Requester: requester,
+			TargetRef: "refs/heads/master",
+		},
+	}
+}
+
+// listAllRevisions lists every page of the given reviews, and returns the revisions on each page.
+func listAllRevisions(t *testing.T, reviews []review.Summary, query ReviewListQuery) []string {
+	t.Helper()
+	var pages []string
+	for {
+		response, err := listReviews(context.Background(), reviews, nil, &query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var revisions []string
+		for _, summary := range response.Items {
+			revisions = append(revisions, summary.Revision)
+		}
+		pages = append(pages, strings.Join(revisions, ","))
+		if response.NextPageToken == "" {
+			return pages
+		}
+		query.PageToken = response.NextPageToken
+	}
+}
+
+func TestListReviewsInOrder(t *testing.T) {
+	reviews := []review.Summary{
+		newTestSummary("a", "carol", 500),
+		newTestSummary("b", "alice", 400),
+		newTestSummary("c", "bob", 300),
+		newTestSummary("d", "alice", 300),
+		newTestSummary("e", "bob", 100),
+	}
+	reviews[4].Comments = []review.CommentThread{{Comment: comment.Comment{Timestamp: "0000000600"}}}
+	sortReviews(reviews, defaultReviewOrder)
+
+	for order, expected := range map[string]string{
+		"":          "a,b|c,d|e",
+		"created":   "a,b|c,d|e",
+		"updated":   "e,a|b,c|d",
+		"requester": "b,d|c,e|a",
+	} {
+		pages := listAllRevisions(t, reviews, ReviewListQuery{Order: order, PageSize: 2})
+		if strings.Join(pages, "|") != expected {
+			t.Errorf("Unexpected pages when sorting by %q: %q", order, pages)
+		}
+	}
+	pages := listAllRevisions(t, reviews, ReviewListQuery{PageSize: 5})
+	if len(pages) != 1 || pages[0] != "a,b,c,d,e" {
+		t.Errorf("Unexpected pages for a page size matching the number of reviews: %q", pages)
+	}
+	pages = listAllRevisions(t, reviews, ReviewListQuery{PageSize: 2, Filter: &ReviewFilter{Requester: "bob"}})
+	if len(pages) != 1 || pages[0] != "c,e" {
+		t.Errorf("Unexpected pages of filtered reviews: %q", pages)
+	}
+}
+
+func TestListReviewsAfterChanges(t *testing.T) {
+	reviews := []review.Summary{
+		newTestSummary("a", "alice", 500),
+		newTestSummary("b", "alice", 400),
+		newTestSummary("c", "alice", 300),
+		newTestSummary("d", "alice", 200),
+	}
+	query := &ReviewListQuery{PageSize: 2}
+	first, err := listReviews(context.Background(), reviews, nil, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Neither a newly requested review, nor closing a review that was already listed, changes the following page.
+	changed := []review.Summary{newTestSummary("z", "alice", 600), reviews[0], reviews[2], reviews[3]}
+	query.PageToken = first.NextPageToken
+	second, err := listReviews(context.Background(), changed, nil, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.Items) != 2 || second.Items[0].Revision != "c" || second.Items[1].Revision != "d" || second.NextPageToken != "" {
+		t.Fatalf("Unexpected page after the list changed: %+v", second)
+	}
+
+	// Closing the last review that was listed does not change the following page either.
+	query.PageToken = first.NextPageToken
+	second, err = listReviews(context.Background(), []review.Summary{reviews[0], reviews[2], reviews[3]}, nil, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.Items) != 2 || second.Items[0].Revision != "c" {
+		t.Fatalf("Unexpected page after the last listed review was closed: %+v", second)
+	}
+
+	query.Order = "updated"
+	if _, err := listReviews(context.Background(), reviews, nil, query); err == nil {
+		t.Error("A page token was accepted for a different sort order")
+	}
+	query.PageToken = "not a token"
+	if _, err := listReviews(context.Background(), reviews, nil, query); err == nil {
+		t.Error("An invalid page token was accepted")
+	}
+}
+
+func TestParseReviewListQuery(t *testing.T) {
+	token := encodePageToken("requester", reviewSortKey{Primary: "bob", Time: 100, Revision: "abc"})
+	query, err := parseReviewListQuery(mustParseQuery(t, "sort=requester&page_size=10&page="+token+"&requester=alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query.Order != "requester" || query.PageSize != 10 || query.PageToken != token || query.Filter.Requester != "alice" {
+		t.Errorf("Unexpected query: %+v", query)
+	}
+	query, err = parseReviewListQuery(mustParseQuery(t, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query.Order != defaultReviewOrder || query.PageSize != defaultReviewPageSize {
+		t.Errorf("Unexpected default query: %+v", query)
+	}
+	for _, invalid := range []string{"sort=size", "page_size=0", "page_size=1001", "page_size=ten", "state=merged",
+		"page=abc", "page=" + token, "sort=updated&page=" + token} {
+		if _, err := parseReviewListQuery(mustParseQuery(t, invalid)); err == nil {
+			t.Errorf("Invalid query %q was accepted", invalid)
+		}
+	}
+}
+
+func TestServeReviewsInvalidPageToken(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewRepoCache()
+	repoDetails := cache.AddConfiguredRepo(repo, RepoConfig{Slug: "test"})
+	v2Handler := http.StripPrefix("/api/v2", cache.V2Handler())
+	token := encodePageToken("created", reviewSortKey{Time: 100, Revision: "abc"})
+	for _, tc := range []struct {
+		url     string
+		handler http.Handler
+	}{
+		{"/api/open_reviews?repo=test&page=abc", http.HandlerFunc(cache.ServeOpenReviewsJSON)},
+		{"/api/closed_reviews?repo=test&sort=updated&page=" + token, http.HandlerFunc(cache.ServeClosedReviewsJSON)},
+		{"/api/v2/repos/test/reviews?page=abc", v2Handler},
+		{"/api/v2/repos/test/reviews?status=closed&sort=updated&page=" + token, v2Handler},
+	} {
+		w := httptest.NewRecorder()
+		tc.handler.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+		if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "page token") {
+			t.Errorf("Unexpected response for %s: %d %q", tc.url, w.Code, w.Body.String())
+		}
+	}
+	if _, err := repoDetails.GetOpenReviews(context.Background(), &ReviewListQuery{PageToken: token}); err != nil {
+		t.Errorf("A valid page token was rejected: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ForwardedPrefixHeader is the header used by reverse proxies to report the
+// path prefix that they stripped before forwarding a request to us.
+const ForwardedPrefixHeader = "X-Forwarded-Prefix"
+
+type basePathKey struct{}
+
+// NormalizeBasePath converts the given base path into a canonical form.
+//
+// The result is either empty, or starts with a slash and has no trailing slash.
+func NormalizeBasePath(basePath string) string {
+	if basePath == "" {
+		return ""
+	}
+	basePath = path.Clean("/" + basePath)
+	if basePath == "/" {
+		return ""
+	}
+	return basePath
+}
+
+// forwardedPrefix returns the normalized path prefix reported in the X-Forwarded-Prefix header.
+//
+// Prefixes containing backslashes or control characters are ignored, since browsers treat a
+// redirect to a path starting with "/\" as a redirect to another host.
+func forwardedPrefix(r *http.Request) string {
+	prefix := r.Header.Get(ForwardedPrefixHeader)
+	for _, c := range prefix {
+		if c == '\\' || c < ' ' || c == 0x7f {
+			return ""
+		}
+	}
+	return NormalizeBasePath(prefix)
+}
+
+// WithBasePath returns a handler that serves the given handler under the given base path.
+//
+// Requests for paths outside of the base path are rejected, and the base path is stripped
+// from the request before it is passed on. The externally-visible prefix can be read by the
+// wrapped handler using the BasePath method.
+//
+// The prefix reported in the X-Forwarded-Prefix header is only included in the externally-visible
+// prefix if trustForwardedPrefix is set, which should only be done when every request comes
+// through a reverse proxy that sets the header. Otherwise, any client could have the prefix,
+// and with it the redirects, point elsewhere.
+func WithBasePath(basePath string, trustForwardedPrefix bool, h http.Handler) http.Handler {
+	basePath = NormalizeBasePath(basePath)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prefix := basePath
+		if trustForwardedPrefix {
+			prefix = forwardedPrefix(r) + basePath
+		}
+		if basePath != "" {
+			if r.URL.Path == basePath {
+				http.Redirect(w, r, prefix+"/", http.StatusMovedPermanently)
+				return
+			}
+			if !strings.HasPrefix(r.URL.Path, basePath+"/") {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		r2 := r.WithContext(context.WithValue(r.Context(), basePathKey{}, prefix))
+		u := *r.URL
+		u.Path = strings.TrimPrefix(r.URL.Path, basePath)
+		u.RawPath = ""
+		r2.URL = &u
+		h.ServeHTTP(w, r2)
+	})
+}
+
+// BasePath returns the externally-visible path prefix under which the given request was received.
+//
+// The result is empty when the server is mounted at the root, or when the request was not
+// received through WithBasePath.
+func BasePath(r *http.Request) string {
+	if prefix, ok := r.Context().Value(basePathKey{}).(string); ok {
+		return prefix
+	}
+	return ""
+}
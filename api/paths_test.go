@@ -0,0 +1,78 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/git-appraise/repository"
+)
+
+func TestNormalizeBasePath(t *testing.T) {
+	for input, expected := range map[string]string{
+		"":           "",
+		"/":          "",
+		"reviews":    "/reviews",
+		"/reviews/":  "/reviews",
+		"//evil.com": "/evil.com",
+		"/a/../b":    "/b",
+	} {
+		if actual := NormalizeBasePath(input); actual != expected {
+			t.Errorf("Unexpected normalization of %q: %q", input, actual)
+		}
+	}
+}
+
+func TestEntryPointRedirectWithBasePath(t *testing.T) {
+	cache := NewRepoCache()
+	repo := repository.NewMockRepoForTest()
+	repoDetails := cache.AddRepo(repo)
+	reviewsPage := "/static/reviews.html#?repo=" + repoDetails.ID()
+	handler := WithBasePath("/reviews/", false, http.HandlerFunc(cache.ServeEntryPointRedirect))
+	proxied := WithBasePath("/reviews/", true, http.HandlerFunc(cache.ServeEntryPointRedirect))
+
+	for _, tc := range []struct {
+		handler         http.Handler
+		path            string
+		forwardedPrefix string
+		status          int
+		location        string
+	}{
+		{handler, "/reviews/", "", http.StatusTemporaryRedirect, "/reviews" + reviewsPage},
+		{handler, "/reviews/", "/proxy", http.StatusTemporaryRedirect, "/reviews" + reviewsPage},
+		{handler, "/reviews", "", http.StatusMovedPermanently, "/reviews/"},
+		{handler, "/other/", "", http.StatusNotFound, ""},
+		{proxied, "/reviews/", "/proxy", http.StatusTemporaryRedirect, "/proxy/reviews" + reviewsPage},
+		{proxied, "/reviews", "/proxy", http.StatusMovedPermanently, "/proxy/reviews/"},
+		{proxied, "/reviews", "/\\evil.com", http.StatusMovedPermanently, "/reviews/"},
+	} {
+		r := httptest.NewRequest("GET", tc.path, nil)
+		if tc.forwardedPrefix != "" {
+			r.Header.Set(ForwardedPrefixHeader, tc.forwardedPrefix)
+		}
+		w := httptest.NewRecorder()
+		tc.handler.ServeHTTP(w, r)
+		if w.Code != tc.status {
+			t.Errorf("Unexpected status for %q: %d", tc.path, w.Code)
+		}
+		if location := w.Header().Get("Location"); location != tc.location {
+			t.Errorf("Unexpected redirect for %q: %q", tc.path, location)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/git-appraise-web/api/types"
+)
+
+const (
+	// The maximum length in bytes of the title read from a repository's README.
+	maxReadmeTitleLength = 200
+)
+
+// The names of the files that a repository's README title is read from, in order of preference.
+var readmeFiles = []string{"README.md", "README.markdown", "README", "README.txt", "README.rst"}
+
+// RepoInfo describes the current state of a repository and its reviews.
+type RepoInfo = types.RepoInfo
+
+// readmeTitle extracts the title from the given contents of a README.
+//
+// That is the first Markdown heading if the README starts with one, and otherwise its first non-empty line.
+func readmeTitle(contents string) string {
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			line = strings.TrimSpace(strings.Trim(line, "#"))
+		}
+		if len(line) > maxReadmeTitleLength {
+			// The title is cut at the start of a rune, so that no rune is split.
+			end := maxReadmeTitleLength
+			for end > 0 && !utf8.RuneStart(line[end]) {
+				end--
+			}
+			line = line[:end]
+		}
+		return line
+	}
+	return ""
+}
+
+// readRepoInfo reads the state of the repository that is not part of its review index.
+//
+// The README title is only read again if HEAD has changed since the given info was read.
+// Anything that cannot be read, such as the branch of a detached HEAD, is left empty.
+func (details *RepoDetails) readRepoInfo(previous *RepoInfo) *RepoInfo {
+	info := &RepoInfo{}
+	if headRef, err := details.Repo.GetHeadRef(); err == nil {
+		info.Branch = strings.TrimPrefix(headRef, "refs/heads/")
+	}
+	head, err := details.Repo.GetCommitHash("HEAD")
+	if err != nil {
+		return info
+	}
+	info.Head = head
+	if previous != nil && previous.Head == head {
+		info.ReadmeTitle = previous.ReadmeTitle
+		return info
+	}
+	for _, name := range readmeFiles {
+		if contents, err := details.Repo.Show(head, name); err == nil {
+			info.ReadmeTitle = readmeTitle(contents)
+			break
+		}
+	}
+	return info
+}
+
+// getRepoInfo describes the repository as of the given index of its reviews.
+//
+// The parts read from git are the ones read by the last update of the index.
+func (details *RepoDetails) getRepoInfo(index *reviewIndex) *RepoInfo {
+	details.mu.Lock()
+	cached := details.info
+	details.mu.Unlock()
+	if cached == nil {
+		cached = &RepoInfo{}
+	}
+	info := *cached
+	info.OpenReviewCount = index.openReviewCount
+	info.ClosedReviewCount = index.closedReviewCount
+	info.AwaitingActionCount = index.awaitingActionCount
+	if !index.latestActivity.IsZero() {
+		latestActivity := index.latestActivity
+		info.LatestActivity = &latestActivity
+	}
+	return &info
+}
+
+// repoListOrders maps the name of each order that repositories can be listed in to a function
+// that reports whether one repository comes before another. Ties are broken by ID.
+var repoListOrders = map[string]func(a, b *RepoListItem) bool{
+	"id": func(a, b *RepoListItem) bool { return false },
+	"name": func(a, b *RepoListItem) bool {
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	},
+	// Repositories with the most recent activity come first.
+	"activity": func(a, b *RepoListItem) bool {
+		return listItemActivity(a).After(listItemActivity(b))
+	},
+	// Repositories with the most open reviews come first.
+	"open": func(a, b *RepoListItem) bool {
+		return listItemInfo(a).OpenReviewCount > listItemInfo(b).OpenReviewCount
+	},
+	// Repositories with the most reviews awaiting action come first.
+	"awaiting": func(a, b *RepoListItem) bool {
+		return listItemInfo(a).AwaitingActionCount > listItemInfo(b).AwaitingActionCount
+	},
+}
+
+// listItemInfo returns the item's RepoInfo, which is empty if the repository has not been read yet.
+func listItemInfo(item *RepoListItem) RepoInfo {
+	if item.RepoInfo == nil {
+		return RepoInfo{}
+	}
+	return *item.RepoInfo
+}
+
+func listItemActivity(item *RepoListItem) time.Time {
+	if latestActivity := listItemInfo(item).LatestActivity; latestActivity != nil {
+		return *latestActivity
+	}
+	return time.Time{}
+}
+
+// sortRepos orders the given repositories by the order with the given name, defaulting to their IDs.
+func sortRepos(repos ReposList, order string) error {
+	if order == "" {
+		order = "id"
+	}
+	less, ok := repoListOrders[order]
+	if !ok {
+		return errors.New("Invalid sort order")
+	}
+	sort.SliceStable(repos, func(i, j int) bool {
+		if less(repos[i], repos[j]) {
+			return true
+		}
+		if less(repos[j], repos[i]) {
+			return false
+		}
+		return repos[i].ID < repos[j].ID
+	})
+	return nil
+}
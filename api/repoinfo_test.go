@@ -0,0 +1,126 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadmeTitle(t *testing.T) {
+	for contents, expected := range map[string]string{
+		"# Git-Appraise Web UI\n\nSome text": "Git-Appraise Web UI",
+		"\n\n## Heading ##\n":                "Heading",
+		"Plain title\n===========\n":         "Plain title",
+		"  \n":                               "",
+		strings.Repeat("a", 199) + "é":       strings.Repeat("a", 199),
+		strings.Repeat("é", 101):             strings.Repeat("é", 100),
+	} {
+		if title := readmeTitle(contents); title != expected {
+			t.Errorf("Unexpected title of %q: %q", contents, title)
+		}
+	}
+}
+
+func TestRepoInfo(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoDetails := NewRepoDetails(repo)
+	if item := repoDetails.GetListItem(); item.RepoInfo != nil {
+		t.Fatalf("A repository that was not read yet has info: %+v", item.RepoInfo)
+	}
+	summary, err := repoDetails.GetSummary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := runTestGit(t, dir, "rev-parse", "HEAD")
+	if summary.Branch != "master" || summary.Head != head || summary.ReadmeTitle != "Test repo" ||
+		summary.OpenReviewCount != 1 || summary.AwaitingActionCount != 1 ||
+		summary.LatestActivity == nil || summary.LatestActivity.Unix() != 1 {
+		t.Fatalf("Unexpected summary: %+v", summary)
+	}
+
+	// Accepting the review means that it is no longer awaiting action.
+	feature := runTestGit(t, dir, "rev-parse", "feature")
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/discuss", "add", "-m",
+		`{"timestamp":"0000000100","author":"reviewer","description":"LGTM","resolved":true}`, feature)
+	item := repoDetails.GetListItem()
+	if item.RepoInfo == nil || item.AwaitingActionCount != 1 {
+		t.Fatalf("Listing the repository did not use the last index: %+v", item.RepoInfo)
+	}
+	if err := repoDetails.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	item = repoDetails.GetListItem()
+	if item.AwaitingActionCount != 0 || item.OpenReviewCount != 1 || item.LatestActivity.Unix() != 100 {
+		t.Fatalf("Unexpected info after accepting the review: %+v", item.RepoInfo)
+	}
+
+	// Checking out another branch only changes HEAD, but that is still picked up by a refresh.
+	runTestGit(t, dir, "checkout", "-q", "feature")
+	if err := repoDetails.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	item = repoDetails.GetListItem()
+	if item.Branch != "feature" || item.Head != feature {
+		t.Fatalf("Unexpected info after checking out another branch: %+v", item.RepoInfo)
+	}
+}
+
+func TestListReposSorted(t *testing.T) {
+	cache := NewRepoCache()
+	for _, tc := range []struct {
+		slug       string
+		withReview bool
+	}{{"a", false}, {"b", true}} {
+		dir := newTestGitDir(t)
+		if tc.withReview {
+			addTestReview(t, dir)
+		}
+		repo, err := NewGitRepo(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		repoDetails := cache.AddConfiguredRepo(repo, RepoConfig{Slug: tc.slug})
+		if err := repoDetails.refresh(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for order, expected := range map[string]string{"": "a", "id": "a", "open": "b", "awaiting": "b", "activity": "b"} {
+		w := httptest.NewRecorder()
+		cache.ServeListReposJSON(w, httptest.NewRequest("GET", "/api/repos?sort="+order, nil))
+		var repos []*RepoListItem
+		if err := json.Unmarshal(w.Body.Bytes(), &repos); err != nil {
+			t.Fatalf("Unexpected response for sorting by %q: %d %q", order, w.Code, w.Body.String())
+		}
+		if len(repos) != 2 || repos[0].ID != expected {
+			t.Errorf("Unexpected first repository when sorting by %q: %q", order, repos[0].ID)
+		}
+	}
+	w := httptest.NewRecorder()
+	cache.ServeListReposJSON(w, httptest.NewRequest("GET", "/api/repos?sort=size", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("Unexpected response for an invalid order: %d", w.Code)
+	}
+}
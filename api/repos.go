@@ -17,150 +17,429 @@ limitations under the License.
 package api
 
 import (
+	"context"
 	"crypto/sha1"
-	"errors"
 	"fmt"
-	"strconv"
+	"log"
+	"path/filepath"
+	"sync"
+	"time"
 
+	"github.com/google/git-appraise-web/api/types"
 	"github.com/google/git-appraise/repository"
 	"github.com/google/git-appraise/review"
 )
 
 // RepoListItem represents one entry in the result of calling the API to list repositories.
-type RepoListItem struct {
-	ID   string `json:"id"`
-	Path string `json:"path"`
-}
+type RepoListItem = types.RepoListItem
 
 // ReposList is the return type for the API to list repositories.
-type ReposList []*RepoListItem
-
-func (repos ReposList) Len() int      { return len(repos) }
-func (repos ReposList) Swap(i, j int) { repos[i], repos[j] = repos[j], repos[i] }
-func (repos ReposList) Less(i, j int) bool {
-	return repos[i].ID < repos[j].ID
-}
+type ReposList = types.ReposList
 
 // RepoSummary is the return type for the API to summarize a repository.
-type RepoSummary struct {
-	Path              string `json:"path"`
-	OpenReviewCount   int    `json:"openReviewCount"`
-	ClosedReviewCount int    `json:"closedReviewCount"`
+type RepoSummary = types.RepoSummary
+
+// indexUpdate represents an in-flight update of a repository's review index.
+//
+// Callers that ask for an update while another is in flight wait for, and share,
+// the result of that one rather than starting their own.
+type indexUpdate struct {
+	done  chan struct{}
+	index *reviewIndex
+	err   error
 }
 
 // RepoDetails encapsulates everything the API server knows about a repository.
+//
+// It is safe for concurrent use. The reviews are held in an immutable snapshot
+// that is replaced, rather than modified, when the repository changes.
 type RepoDetails struct {
-	ID                string
-	Repo              repository.Repo
-	RepoState         string
-	OpenReviewCount   int
-	OpenReviews       [][]review.Summary
-	ClosedReviewCount int
-	ClosedReviews     [][]review.Summary
+	Repo repository.Repo
+	// legacyID is the hash-based ID that identified the repository before it had a slug.
+	legacyID string
+
+	mu sync.Mutex
+	// id is the repository's slug, which identifies it in URLs.
+	id       string
+	index    *reviewIndex
+	inFlight *indexUpdate
+	// reindexPending is set when the next update should read every review again, rather than reuse the index.
+	reindexPending bool
+	// backgroundRefresh is set while the index is kept up to date in the background.
+	backgroundRefresh bool
+	// lastRefresh is the last time that the reviews were successfully read from the repository.
+	lastRefresh time.Time
+	// lastError is the error returned by the most recent attempt to read the repository, if any.
+	lastError error
+
+	// store, if set, persists the index so that it survives restarts.
+	store *IndexStore
+	// storedState is the state of the repository when its index was last stored. This
+	// is only accessed by the update that is in flight, before it stops being in flight.
+	storedState string
+
+	// caches, if set, holds the reviews that were recently read from the repository.
+	caches *Caches
+
+	// metadata describes the repository to the people browsing it.
+	metadata RepoMetadata
+
+	// mirror, if set, describes the upstream that the repository is kept in sync with, and
+	// syncStatus reports the outcome of the last sync.
+	mirror     *MirrorConfig
+	syncStatus SyncStatus
+
+	// info holds the parts of the repository's RepoInfo that are read from git, as of the
+	// last update of the index.
+	info *RepoInfo
+
+	// hashLength is the length of the repository's object hashes, or zero if that is not known yet.
+	hashLength int
+
+	// gone is set once the repository no longer exists, at which point stop is closed.
+	gone bool
+	stop chan struct{}
 }
 
 // Get a fixed-length, obfuscated ID for the given repo.
+//
+// These IDs are no longer used in URLs, but they are still accepted so that old links keep working.
 func getRepoID(repo repository.Repo) string {
 	return fmt.Sprintf("%.6x", sha1.Sum([]byte(repo.GetPath())))
 }
 
 // NewRepoDetails constructs a RepoDetails instance from the given Repo instance.
 func NewRepoDetails(repo repository.Repo) *RepoDetails {
-	return &RepoDetails{
-		ID:   getRepoID(repo),
-		Repo: repo,
+	legacyID := getRepoID(repo)
+	slug := slugify(filepath.Base(repo.GetPath()))
+	if slug == "" {
+		slug = legacyID
+	}
+	details := &RepoDetails{
+		id:       slug,
+		Repo:     repo,
+		legacyID: legacyID,
+		stop:     make(chan struct{}),
+	}
+	details.setMetadata(RepoMetadata{})
+	return details
+}
+
+// ID returns the repository's slug, which identifies it in URLs.
+func (details *RepoDetails) ID() string {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	return details.id
+}
+
+func (details *RepoDetails) setID(id string) {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	details.id = id
+}
+
+// setMetadata replaces the metadata describing the repository, filling in a default name if it has none.
+func (details *RepoDetails) setMetadata(metadata RepoMetadata) {
+	if metadata.Name == "" {
+		metadata.Name = filepath.Base(details.Repo.GetPath())
 	}
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	details.metadata = metadata
 }
 
-func (details *RepoDetails) update() error {
+func (details *RepoDetails) getMetadata() RepoMetadata {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	return details.metadata
+}
+
+func (details *RepoDetails) isGone() bool {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	return details.gone
+}
+
+// markGone records that the repository no longer exists.
+//
+// This stops refreshing the repository in the background, and releases its reviews.
+func (details *RepoDetails) markGone() {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	if details.gone {
+		return
+	}
+	details.gone = true
+	details.index = nil
+	details.backgroundRefresh = false
+	close(details.stop)
+}
+
+// getHashLength returns the length of the repository's object hashes, which depends on its object format.
+func (details *RepoDetails) getHashLength() int {
+	details.mu.Lock()
+	hashLength := details.hashLength
+	details.mu.Unlock()
+	if hashLength != 0 {
+		return hashLength
+	}
+	hashLength, err := objectHashLength(details.Repo.GetPath())
+	if err != nil {
+		// The object format is read again next time, rather than assuming SHA1 from then on.
+		log.Printf("Unable to read the object format of %q: %v", details.Repo.GetPath(), err)
+		return hashLength
+	}
+	details.mu.Lock()
+	details.hashLength = hashLength
+	details.mu.Unlock()
+	return hashLength
+}
+
+// checkHash checks that the given string is a hash, or a prefix of one, in the repository's object format.
+func (details *RepoDetails) checkHash(s string) error {
+	return checkStringLooksLikeHash(s, details.getHashLength())
+}
+
+// rebuild returns an index of the repository's current reviews, reusing the given index if
+// the repository has not changed since it was built.
+//
+// If reindex is set, then every review is read again, and neither the given index nor the stored one is used.
+func (details *RepoDetails) rebuild(current *reviewIndex, reindex bool) (*reviewIndex, error) {
+	if reindex {
+		stateHash, err := details.Repo.GetRepoStateHash()
+		if err != nil {
+			return nil, err
+		}
+		return newReviewIndex(details.Repo, stateHash), nil
+	}
+	if current == nil && details.store != nil {
+		stored, err := details.store.load(details.Repo)
+		if err != nil {
+			log.Printf("Unable to load the stored reviews for %q: %v", details.Repo.GetPath(), err)
+		} else if stored != nil {
+			current = stored
+			details.storedState = stored.repoState
+		}
+	}
 	stateHash, err := details.Repo.GetRepoStateHash()
 	if err != nil {
-		return err
-	}
-	if stateHash == details.RepoState {
-		return nil
-	}
-	allReviews := review.ListAll(details.Repo)
-	var openReviews []review.Summary
-	var closedReviews []review.Summary
-	for _, review := range allReviews {
-		if review.Submitted || review.Request.TargetRef == "" {
-			closedReviews = append(closedReviews, review)
-		} else {
-			openReviews = append(openReviews, review)
+		return nil, err
+	}
+	if current != nil && stateHash == current.repoState {
+		return current, nil
+	}
+	if current != nil && current.refs != nil {
+		index, err := current.update(details.Repo, stateHash)
+		if err == nil {
+			return index, nil
 		}
+		log.Printf("Unable to incrementally update the reviews in %q: %v", details.Repo.GetPath(), err)
 	}
-	details.OpenReviewCount = len(openReviews)
-	details.OpenReviews = paginateReviews(openReviews, 100)
-	details.ClosedReviewCount = len(closedReviews)
-	details.ClosedReviews = paginateReviews(closedReviews, 100)
-	details.RepoState = stateHash
-	return nil
+	return newReviewIndex(details.Repo, stateHash), nil
+}
+
+// update brings the review index up to date with the repository, and returns it.
+//
+// If an update is already in flight, then this waits for it to finish and returns its result.
+func (details *RepoDetails) update() (*reviewIndex, error) {
+	details.mu.Lock()
+	if pending := details.inFlight; pending != nil {
+		details.mu.Unlock()
+		<-pending.done
+		return pending.index, pending.err
+	}
+	pending := &indexUpdate{done: make(chan struct{})}
+	details.inFlight = pending
+	current := details.index
+	currentInfo := details.info
+	reindex := details.reindexPending
+	details.reindexPending = false
+	details.mu.Unlock()
+
+	pending.index, pending.err = details.rebuild(current, reindex)
+	var info *RepoInfo
+	if pending.err == nil {
+		// HEAD is read on every update, since switching branches does not change the repository state.
+		info = details.readRepoInfo(currentInfo)
+		// The index is stored before the update stops being in flight, so that stores never overlap,
+		// and an older index never replaces a newer one. The reviews of a repository that is gone
+		// are not stored.
+		if !details.isGone() {
+			details.storeIndex(pending.index)
+		}
+	}
+
+	details.mu.Lock()
+	details.inFlight = nil
+	details.lastError = pending.err
+	if pending.err != nil && reindex {
+		details.reindexPending = true
+	}
+	// The reviews of a repository that is gone are not kept.
+	if pending.err == nil && !details.gone {
+		details.index = pending.index
+		details.info = info
+		details.lastRefresh = time.Now()
+	}
+	details.mu.Unlock()
+	close(pending.done)
+	return pending.index, pending.err
+}
+
+// storeIndex persists the given index, unless it is already stored.
+func (details *RepoDetails) storeIndex(index *reviewIndex) {
+	if details.store == nil || index.repoState == details.storedState {
+		return
+	}
+	if err := details.store.save(details.Repo.GetPath(), index); err != nil {
+		log.Printf("Unable to store the reviews for %q: %v", details.Repo.GetPath(), err)
+		return
+	}
+	details.storedState = index.repoState
+}
+
+// refresh brings the details up to date with the repository.
+func (details *RepoDetails) refresh() error {
+	_, err := details.update()
+	return err
+}
+
+// reindex reads every review in the repository again, discarding what was read before.
+//
+// This recovers from an index that is out of sync with the repository, e.g. after the
+// repository's notes were rewritten by hand.
+func (details *RepoDetails) reindex() error {
+	details.mu.Lock()
+	details.reindexPending = true
+	details.mu.Unlock()
+	for {
+		_, err := details.update()
+		if err != nil {
+			return err
+		}
+		details.mu.Lock()
+		done := !details.reindexPending
+		details.mu.Unlock()
+		// An update that was already in flight may have reused the old index, so this waits for one that did not.
+		if done {
+			return nil
+		}
+	}
+}
+
+// snapshot returns the review index that requests should be served from.
+//
+// When the index is kept up to date in the background, this is the latest index. Otherwise,
+// the index is first brought up to date with the repository.
+func (details *RepoDetails) snapshot() (*reviewIndex, error) {
+	details.mu.Lock()
+	index := details.index
+	backgroundRefresh := details.backgroundRefresh
+	details.mu.Unlock()
+	if backgroundRefresh && index != nil {
+		return index, nil
+	}
+	return details.update()
 }
 
 // GetReview loads the given review details from the repository.
-func (details *RepoDetails) GetReview(reviewID string) (*review.Review, error) {
-	if err := details.update(); err != nil {
+//
+// The returned review runs its git commands under the given context.
+func (details *RepoDetails) GetReview(ctx context.Context, reviewID string) (*review.Review, error) {
+	index, err := details.snapshot()
+	if err != nil {
 		return nil, err
 	}
-	reviewDetails, err := review.Get(details.Repo, reviewID)
-	if err != nil {
-		return nil, errors.New("Invalid review specified")
+	cacheKey := details.legacyID + ":" + index.repoState + ":" + reviewID
+	if details.caches != nil {
+		if cached, ok := details.caches.reviews.get(cacheKey); ok {
+			return reviewWithContext(ctx, cached.(*review.Review)), nil
+		}
+	}
+	reviewDetails, err := review.Get(repoWithContext(ctx, details.Repo), reviewID)
+	// There is no error when the revision exists but has no review.
+	if err != nil || reviewDetails == nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, &TimeoutError{Op: "loading the review", Err: ctxErr}
+		}
+		return nil, errUnknownReview
+	}
+	// A review read under a context that is now done may be missing the notes that could not be read in time.
+	if details.caches != nil && ctx.Err() == nil {
+		cached := reviewWithContext(context.Background(), reviewDetails)
+		details.caches.reviews.add(cacheKey, cached, encodedSize(cached))
 	}
 	return reviewDetails, nil
 }
 
 // GetSummary constructs a detailed summary of the repository.
 func (details *RepoDetails) GetSummary() (*RepoSummary, error) {
-	if err := details.update(); err != nil {
+	index, err := details.snapshot()
+	if err != nil {
 		return nil, err
 	}
 	return &RepoSummary{
-		Path:              details.Repo.GetPath(),
-		OpenReviewCount:   details.OpenReviewCount,
-		ClosedReviewCount: details.ClosedReviewCount,
+		ID:           details.ID(),
+		Path:         details.Repo.GetPath(),
+		RepoMetadata: details.getMetadata(),
+		RepoInfo:     *details.getRepoInfo(index),
+		Sync:         details.getSyncStatus(),
 	}, nil
 }
 
 // GetListItem constructs a concise summary of the repository suitable for including in a list of repositories.
+//
+// Listing repositories does not wait for any of them to be read, so the summary describes the
+// repository as of the last time it was read.
 func (details *RepoDetails) GetListItem() *RepoListItem {
-	return &RepoListItem{
-		ID:   details.ID,
-		Path: details.Repo.GetPath(),
+	details.mu.Lock()
+	index := details.index
+	details.mu.Unlock()
+	item := &RepoListItem{
+		ID:           details.ID(),
+		Path:         details.Repo.GetPath(),
+		RepoMetadata: details.getMetadata(),
 	}
+	if index != nil {
+		item.RepoInfo = details.getRepoInfo(index)
+	}
+	return item
 }
 
-func getReviewListResponse(pageToken int, reviews [][]review.Summary) *ReviewListResponse {
-	var items []review.Summary
-	var nextPageToken string
-	if pageToken < len(reviews) {
-		items = reviews[pageToken]
-		if pageToken < len(reviews)-1 {
-			nextPageToken = strconv.Itoa(pageToken + 1)
-		}
-	}
-	return &ReviewListResponse{
-		Items:         items,
-		NextPageToken: nextPageToken,
+// GetClosedReviews returns the page of the list of closed reviews that is selected by the given query.
+func (details *RepoDetails) GetClosedReviews(ctx context.Context, query *ReviewListQuery) (*ReviewListResponse, error) {
+	index, err := details.snapshot()
+	if err != nil {
+		return nil, err
 	}
+	return listReviews(ctx, index.closedReviews, index.touched, query)
 }
 
-// GetClosedReviews returns the given `page` of the paginated list of closed reviews.
-//
-// If the page is out of bounds, then an empty response is returned.
-func (details *RepoDetails) GetClosedReviews(pageToken int) (*ReviewListResponse, error) {
-	if err := details.update(); err != nil {
+// GetOpenReviews returns the page of the list of open reviews that is selected by the given query.
+func (details *RepoDetails) GetOpenReviews(ctx context.Context, query *ReviewListQuery) (*ReviewListResponse, error) {
+	index, err := details.snapshot()
+	if err != nil {
 		return nil, err
 	}
-	return getReviewListResponse(pageToken, details.ClosedReviews), nil
+	return listReviews(ctx, index.openReviews, index.touched, query)
 }
 
-// GetOpenReviews returns the given `page` of the paginated list of open reviews.
-//
-// If the page is out of bounds, then an empty response is returned.
-func (details *RepoDetails) GetOpenReviews(pageToken int) (*ReviewListResponse, error) {
-	if err := details.update(); err != nil {
-		return nil, err
+// GetStatus reports whether or not the reviews in the repository could be read.
+func (details *RepoDetails) GetStatus() *RepoStatus {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	status := &RepoStatus{
+		ID:      details.id,
+		Path:    details.Repo.GetPath(),
+		Indexed: details.index != nil,
+		Healthy: details.index != nil && details.lastError == nil,
+	}
+	if !details.lastRefresh.IsZero() {
+		lastRefresh := details.lastRefresh
+		status.LastRefresh = &lastRefresh
+	}
+	if details.lastError != nil {
+		status.Error = details.lastError.Error()
 	}
-	return getReviewListResponse(pageToken, details.OpenReviews), nil
+	return status
 }
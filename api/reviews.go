@@ -17,48 +17,20 @@ limitations under the License.
 package api
 
 import (
-	"github.com/google/git-appraise/repository"
+	"context"
+
+	"github.com/google/git-appraise-web/api/types"
 	"github.com/google/git-appraise/review"
 )
 
 // CommitOverview encapsulates the fine-grained details of a commit.
-type CommitOverview struct {
-	ID      string                    `json:"id"`
-	Details *repository.CommitDetails `json:"details"`
-}
+type CommitOverview = types.CommitOverview
 
 // DiffSummary summarizes one of the diffs included in a review.
-//
-// This summary includes the list of all commits that can be used to construct such a diff.
-type DiffSummary struct {
-	ReviewCommits []CommitOverview `json:"reviewCommits,omitEmpty"`
-	LeftHandSide  string           `json:"leftHandSide"`
-	RightHandSide string           `json:"rightHandSide"`
-	Contents      string           `json:"contents"`
-}
+type DiffSummary = types.DiffSummary
 
 // ReviewListResponse represents a single `page` in a list of reviews.
-type ReviewListResponse struct {
-	Items         []review.Summary `json:"items"`
-	NextPageToken string           `json:"nextPageToken,omitEmpty"`
-}
-
-func paginateReviews(reviews []review.Summary, maxPerPage int) [][]review.Summary {
-	var results [][]review.Summary
-	var currID int
-	var currPage []review.Summary
-	for _, r := range reviews {
-		if currID >= maxPerPage {
-			results = append(results, currPage)
-			currPage = nil
-			currID = 0
-		}
-		currPage = append(currPage, r)
-		currID++
-	}
-	results = append(results, currPage)
-	return results
-}
+type ReviewListResponse = types.ReviewListResponse
 
 // getReviewBase gets the earliest commit that can be used as a left-hand-side
 // when generating diffs for a review.
@@ -87,19 +59,22 @@ func getReviewBase(r *review.Review) (string, error) {
 //
 // If the `lhs` or `rhs` arguments are empty or out of bounds, then the
 // review's base or head commits are used instead.
-func NewDiffSummary(reviewDetails *review.Review, lhs, rhs string) (*DiffSummary, error) {
+//
+// The git commands used to compute the summary are killed if the given context is done.
+func NewDiffSummary(ctx context.Context, reviewDetails *review.Review, lhs, rhs string) (*DiffSummary, error) {
+	reviewDetails = reviewWithContext(ctx, reviewDetails)
 	base, err := getReviewBase(reviewDetails)
 	if err != nil {
-		return nil, err
+		return nil, checkContext(ctx, "finding the base of the review", err)
 	}
 	head, err := reviewDetails.GetHeadCommit()
 	if err != nil {
-		return nil, err
+		return nil, checkContext(ctx, "finding the head of the review", err)
 	}
 	reviewCommits := []string{base}
 	subsequentCommits, err := reviewDetails.Repo.ListCommitsBetween(base, head)
 	if err != nil {
-		return nil, err
+		return nil, checkContext(ctx, "listing the commits in the review", err)
 	}
 	reviewCommits = append(reviewCommits, subsequentCommits...)
 	commitsMap := make(map[string]interface{})
@@ -108,7 +83,7 @@ func NewDiffSummary(reviewDetails *review.Review, lhs, rhs string) (*DiffSummary
 		commitsMap[commit] = nil
 		details, err := reviewDetails.Repo.GetCommitDetails(commit)
 		if err != nil {
-			return nil, err
+			return nil, checkContext(ctx, "reading the details of commit "+commit, err)
 		}
 		commitOverviews = append(commitOverviews, CommitOverview{
 			ID:      commit,
@@ -118,7 +93,7 @@ func NewDiffSummary(reviewDetails *review.Review, lhs, rhs string) (*DiffSummary
 	if _, ok := commitsMap[lhs]; !ok {
 		lhs, err = reviewDetails.GetBaseCommit()
 		if err != nil {
-			return nil, err
+			return nil, checkContext(ctx, "finding the base commit of the review", err)
 		}
 	}
 	if _, ok := commitsMap[rhs]; !ok {
@@ -126,7 +101,7 @@ func NewDiffSummary(reviewDetails *review.Review, lhs, rhs string) (*DiffSummary
 	}
 	diff, err := reviewDetails.Repo.Diff(lhs, rhs)
 	if err != nil {
-		return nil, err
+		return nil, checkContext(ctx, "computing the diff", err)
 	}
 	return &DiffSummary{
 		ReviewCommits: commitOverviews,
@@ -17,6 +17,8 @@ limitations under the License.
 package api
 
 import (
+	"context"
+
 	"github.com/google/git-appraise/repository"
 
 	"testing"
@@ -25,12 +27,12 @@ import (
 func TestDiffSummary(t *testing.T) {
 	repo := repository.NewMockRepoForTest()
 	repoDetails := NewRepoDetails(repo)
-	reviewDetails, err := repoDetails.GetReview(repository.TestCommitG)
+	reviewDetails, err := repoDetails.GetReview(context.Background(), repository.TestCommitG)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	fullDiffSummary, err := NewDiffSummary(reviewDetails, "", "")
+	fullDiffSummary, err := NewDiffSummary(context.Background(), reviewDetails, "", "")
 	if len(fullDiffSummary.ReviewCommits) != 5 {
 		t.Fatalf("Unexpected list of included diffs: %v", fullDiffSummary.ReviewCommits)
 	}
@@ -45,7 +47,7 @@ func TestDiffSummary(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	firstDiffSummary, err := NewDiffSummary(reviewDetails, repository.TestCommitE, repository.TestCommitG)
+	firstDiffSummary, err := NewDiffSummary(context.Background(), reviewDetails, repository.TestCommitE, repository.TestCommitG)
 	if len(firstDiffSummary.ReviewCommits) != 5 {
 		t.Fatalf("Unexpected list of included diffs: %v", firstDiffSummary.ReviewCommits)
 	}
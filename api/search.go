@@ -0,0 +1,272 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/git-appraise/review"
+)
+
+// The states that reviews can be filtered by, which are based on the votes of their reviewers.
+const (
+	reviewStateAccepted = "accepted"
+	reviewStateRejected = "rejected"
+	reviewStatePending  = "pending"
+)
+
+// ReviewFilter selects the reviews whose properties match every one of its non-empty fields.
+type ReviewFilter struct {
+	Requester string
+	// Reviewer matches the reviews that list the given reviewer.
+	Reviewer string
+	// TargetRef matches the reviews targeting the given ref. A branch may be given by its short name.
+	TargetRef string
+	// State is one of "accepted", "rejected", or "pending".
+	State string
+	// PathPrefix matches the reviews that touch the file with the given path, or a file beneath
+	// the directory with that path. Only whole path components are matched.
+	PathPrefix string
+	// Description matches the reviews whose description contains the given text, ignoring case.
+	Description string
+	// The reviews are matched by the time that they were first requested, and by the time of
+	// their latest request or comment. Zero times leave the corresponding range unbounded.
+	CreatedAfter, CreatedBefore time.Time
+	UpdatedAfter, UpdatedBefore time.Time
+}
+
+// parseFilterTime parses a time given in a URL parameter, either as a date, or as an RFC 3339 timestamp.
+func parseFilterTime(query url.Values, param string) (time.Time, error) {
+	value := query.Get(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.New("Invalid " + param + " parameter")
+	}
+	return t, nil
+}
+
+// parseReviewFilter reads the filter given by the URL parameters of a request to list reviews.
+//
+// The parameters are "requester", "reviewer", "target", "state", "path", "description",
+// "created_after", "created_before", "updated_after", and "updated_before".
+func parseReviewFilter(query url.Values) (*ReviewFilter, error) {
+	filter := &ReviewFilter{
+		Requester:   query.Get("requester"),
+		Reviewer:    query.Get("reviewer"),
+		TargetRef:   query.Get("target"),
+		State:       query.Get("state"),
+		PathPrefix:  query.Get("path"),
+		Description: query.Get("description"),
+	}
+	switch filter.State {
+	case "", reviewStateAccepted, reviewStateRejected, reviewStatePending:
+	default:
+		return nil, errors.New("Invalid state parameter")
+	}
+	var err error
+	for param, t := range map[string]*time.Time{
+		"created_after":  &filter.CreatedAfter,
+		"created_before": &filter.CreatedBefore,
+		"updated_after":  &filter.UpdatedAfter,
+		"updated_before": &filter.UpdatedBefore,
+	} {
+		if *t, err = parseFilterTime(query, param); err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
+
+// isEmpty reports whether the filter matches every review.
+func (filter *ReviewFilter) isEmpty() bool {
+	return *filter == ReviewFilter{}
+}
+
+// getCreationTime returns the time that the given review was first requested.
+func getCreationTime(summary *review.Summary) time.Time {
+	created := parseTimestamp(summary.Request.Timestamp)
+	for _, request := range summary.AllRequests {
+		if t := parseTimestamp(request.Timestamp); !t.IsZero() && t.Before(created) {
+			created = t
+		}
+	}
+	return created
+}
+
+// inRange reports whether the given time is within the given bounds, either of which may be zero.
+func inRange(t, after, before time.Time) bool {
+	return (after.IsZero() || !t.Before(after)) && (before.IsZero() || t.Before(before))
+}
+
+// matches reports whether the given review matches the filter, ignoring the path prefix, which
+// can only be checked by reading the review's commits.
+func (filter *ReviewFilter) matches(summary *review.Summary) bool {
+	request := &summary.Request
+	if filter.Requester != "" && request.Requester != filter.Requester {
+		return false
+	}
+	if filter.Reviewer != "" {
+		found := false
+		for _, reviewer := range request.Reviewers {
+			found = found || reviewer == filter.Reviewer
+		}
+		if !found {
+			return false
+		}
+	}
+	if filter.TargetRef != "" && request.TargetRef != filter.TargetRef &&
+		request.TargetRef != "refs/heads/"+filter.TargetRef {
+		return false
+	}
+	switch filter.State {
+	case reviewStateAccepted:
+		if summary.Resolved == nil || !*summary.Resolved {
+			return false
+		}
+	case reviewStateRejected:
+		if summary.Resolved == nil || *summary.Resolved {
+			return false
+		}
+	case reviewStatePending:
+		if summary.Resolved != nil {
+			return false
+		}
+	}
+	if filter.Description != "" &&
+		!strings.Contains(strings.ToLower(request.Description), strings.ToLower(filter.Description)) {
+		return false
+	}
+	if !inRange(getCreationTime(summary), filter.CreatedAfter, filter.CreatedBefore) {
+		return false
+	}
+	return inRange(getLatestActivity(summary), filter.UpdatedAfter, filter.UpdatedBefore)
+}
+
+// revisionLists holds a list of strings for each review in an index, keyed by the review's
+// revision, such as the paths of the files changed by each review. The lists are only read once
+// they are needed.
+//
+// It is safe for concurrent use, and a nil *revisionLists caches nothing.
+type revisionLists struct {
+	mu    sync.Mutex
+	lists map[string][]string
+}
+
+func newRevisionLists() *revisionLists {
+	return &revisionLists{lists: make(map[string][]string)}
+}
+
+func (lists *revisionLists) get(revision string) ([]string, bool) {
+	if lists == nil {
+		return nil, false
+	}
+	lists.mu.Lock()
+	defer lists.mu.Unlock()
+	list, ok := lists.lists[revision]
+	return list, ok
+}
+
+func (lists *revisionLists) add(revision string, list []string) {
+	if lists == nil {
+		return
+	}
+	lists.mu.Lock()
+	defer lists.mu.Unlock()
+	lists.lists[revision] = list
+}
+
+// readTouchedPaths returns the paths of the files that the given review changes.
+//
+// Those are the files that differ between its base and head commits, as in the review's diff.
+func readTouchedPaths(ctx context.Context, summary review.Summary) ([]string, error) {
+	summary.Repo = repoWithContext(ctx, summary.Repo)
+	r := &review.Review{Summary: &summary}
+	base, err := getReviewBase(r)
+	if err != nil {
+		return nil, err
+	}
+	head, err := r.GetHeadCommit()
+	if err != nil {
+		return nil, err
+	}
+	out, err := r.Repo.Diff(base, head, "--name-only")
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, path := range strings.Split(out, "\n") {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+// isPathPrefix reports whether the given path is the given prefix, or lies beneath the directory that it names.
+func isPathPrefix(prefix, path string) bool {
+	return path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/")
+}
+
+// touchesPathPrefix reports whether the given review changes the file with the given path, or a file beneath it.
+//
+// The review's paths are read from the given cache if they are in it, and added to it otherwise.
+func touchesPathPrefix(ctx context.Context, touched *revisionLists, summary review.Summary, prefix string) (bool, error) {
+	paths, ok := touched.get(summary.Revision)
+	if !ok {
+		var err error
+		paths, err = readTouchedPaths(ctx, summary)
+		if err != nil {
+			return false, err
+		}
+		touched.add(summary.Revision, paths)
+	}
+	for _, path := range paths {
+		if isPathPrefix(prefix, path) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchesWithPaths reports whether the given review matches the filter, including its path prefix.
+//
+// Reviews whose files cannot be read do not match a path prefix, but if the given context is
+// done before the files have been read, then an error is returned.
+func (filter *ReviewFilter) matchesWithPaths(ctx context.Context, touched *revisionLists, summary *review.Summary) (bool, error) {
+	if !filter.matches(summary) {
+		return false, nil
+	}
+	if filter.PathPrefix == "" {
+		return true, nil
+	}
+	matched, err := touchesPathPrefix(ctx, touched, *summary, filter.PathPrefix)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, &TimeoutError{Op: "reading the files touched by each review", Err: ctxErr}
+	}
+	return err == nil && matched, nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// addTestReviewOf creates a branch with a commit that adds the given file, and requests a review of
+// it with the given request, returning the reviewed commit.
+func addTestReviewOf(t *testing.T, dir, branch, file, request string) string {
+	t.Helper()
+	runTestGit(t, dir, "checkout", "-q", "-b", branch, "master")
+	if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(file)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, file), []byte(branch+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runTestGit(t, dir, "add", file)
+	runTestGit(t, dir, "commit", "-q", "-m", "Change "+file)
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/reviews", "add", "-m", request, "HEAD")
+	revision := runTestGit(t, dir, "rev-parse", "HEAD")
+	runTestGit(t, dir, "checkout", "-q", "master")
+	return revision
+}
+
+func TestFilterReviews(t *testing.T) {
+	dir := newTestGitDir(t)
+	guide := addTestReviewOf(t, dir, "guide", "docs/guide.md",
+		`{"timestamp":"0000000100","reviewRef":"refs/heads/guide","targetRef":"refs/heads/master","requester":"alice","reviewers":["bob"],"description":"Add the user guide"}`)
+	fix := addTestReviewOf(t, dir, "fix", "src/main.go",
+		`{"timestamp":"0000200000","reviewRef":"refs/heads/fix","targetRef":"refs/heads/master","requester":"carol","reviewers":["bob","dave"],"description":"Fix the crash in main"}`)
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/discuss", "add", "-m",
+		`{"timestamp":"0000300000","author":"bob","description":"LGTM","resolved":true}`, fix)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewRepoCache()
+	repoDetails := cache.AddRepo(repo)
+
+	for query, expected := range map[string][]string{
+		"":                                   {guide, fix},
+		"requester=alice":                    {guide},
+		"reviewer=bob":                       {guide, fix},
+		"reviewer=dave":                      {fix},
+		"target=master":                      {guide, fix},
+		"target=refs/heads/release":          nil,
+		"state=accepted":                     {fix},
+		"state=pending":                      {guide},
+		"state=rejected":                     nil,
+		"path=docs/":                         {guide},
+		"path=docs":                          {guide},
+		"path=src/main.go":                   {fix},
+		"path=src/main":                      nil,
+		"path=do":                            nil,
+		"path=README.md":                     nil,
+		"description=CRASH":                  {fix},
+		"created_after=1970-01-02":           {fix},
+		"created_before=1970-01-02":          {guide},
+		"updated_after=1970-01-04T00:00:00Z": {fix},
+		"reviewer=bob&state=pending":         {guide},
+	} {
+		w := httptest.NewRecorder()
+		cache.ServeOpenReviewsJSON(w, httptest.NewRequest("GET", "/api/open_reviews?repo="+repoDetails.ID()+"&"+query, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("Unexpected response for %q: %d %q", query, w.Code, w.Body.String())
+		}
+		var response ReviewListResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatal(err)
+		}
+		var revisions []string
+		for _, item := range response.Items {
+			revisions = append(revisions, item.Revision)
+		}
+		sort.Strings(revisions)
+		sort.Strings(expected)
+		if strings.Join(revisions, ",") != strings.Join(expected, ",") {
+			t.Errorf("Unexpected reviews matching %q: %v", query, revisions)
+		}
+	}
+
+	// The paths touched by the reviews were cached while filtering by path. Commenting on one
+	// review only drops the paths of that review.
+	runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/discuss", "add", "-m",
+		`{"timestamp":"0000400000","author":"bob","description":"Nit"}`, guide)
+	if err := repoDetails.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	index, err := repoDetails.snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := index.touched.get(fix); !ok {
+		t.Error("The paths touched by an unchanged review were not kept")
+	}
+	if _, ok := index.touched.get(guide); ok {
+		t.Error("The paths touched by a changed review were kept")
+	}
+
+	for _, query := range []string{"state=merged", "created_after=yesterday"} {
+		if _, err := parseReviewFilter(mustParseQuery(t, query)); err == nil {
+			t.Errorf("Invalid filter %q was accepted", query)
+		}
+	}
+}
+
+func mustParseQuery(t *testing.T, query string) url.Values {
+	t.Helper()
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return values
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// The maximum length of a repository slug.
+	maxSlugLength = 100
+)
+
+func isSlugCharacter(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '_' || c == '.'
+}
+
+// slugify converts the given name, which may be a relative path, into a repository slug.
+//
+// Path separators and any other characters that are not allowed in a slug are replaced by
+// dashes, and the ".git" suffix of bare repositories is dropped.
+func slugify(name string) string {
+	name = strings.TrimSuffix(filepath.ToSlash(name), ".git")
+	slug := strings.Map(func(c rune) rune {
+		if isSlugCharacter(c) {
+			return c
+		}
+		return '-'
+	}, name)
+	slug = strings.Trim(slug, "-.")
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+	}
+	return slug
+}
+
+// The errors returned for repository parameters that cannot be slugs.
+var (
+	errInvalidRepo          = errors.New("Invalid repository parameter")
+	errInvalidRepoCharacter = errors.New("Invalid repository character")
+)
+
+func checkStringLooksLikeSlug(s string) error {
+	if len(s) > maxSlugLength {
+		return errInvalidRepo
+	}
+	for _, c := range s {
+		if !isSlugCharacter(c) {
+			return errInvalidRepoCharacter
+		}
+	}
+	return nil
+}
+
+// RedirectLegacyIDs wraps the given handler so that requests which identify a repository by
+// its legacy, hash-based ID are redirected to the same URL with the repository's slug instead.
+func (cache *RepoCache) RedirectLegacyIDs(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		repoParam := query.Get("repo")
+		cache.mu.RLock()
+		_, isSlug := cache.repos[repoParam]
+		slug, isLegacyID := cache.aliases[repoParam]
+		cache.mu.RUnlock()
+		if isSlug || !isLegacyID {
+			h.ServeHTTP(w, r)
+			return
+		}
+		query.Set("repo", slug)
+		// A relative reference that only has a query keeps the rest of the URL, including any path prefix.
+		w.Header().Set("Location", "?"+query.Encode())
+		w.WriteHeader(http.StatusPermanentRedirect)
+	})
+}
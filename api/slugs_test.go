@@ -0,0 +1,111 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	for name, expected := range map[string]string{
+		"git-appraise":   "git-appraise",
+		"team/a":         "team-a",
+		"mirror.git":     "mirror",
+		"My Project!":    "My-Project",
+		".hidden":        "hidden",
+		"":               "",
+		"under_score.v2": "under_score.v2",
+	} {
+		if slug := slugify(name); slug != expected {
+			t.Errorf("Unexpected slug for %q: %q", name, slug)
+		}
+	}
+}
+
+func TestSlugs(t *testing.T) {
+	root := newTestTree(t, []string{"team/app", "other/app"}, []string{"team/app", "other/app"})
+	runTestGit(t, filepath.Join(root, "team", "app"), "commit", "-q", "--allow-empty", "-m", "Initial commit")
+	cache := NewRepoCache()
+	first, err := NewGitRepo(filepath.Join(root, "team", "app"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := NewGitRepo(filepath.Join(root, "other", "app"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	firstDetails := cache.AddRepo(first)
+	secondDetails := cache.AddConfiguredRepo(second, RepoConfig{Slug: "app"})
+	if firstDetails.ID() != "app" || secondDetails.ID() != "app-"+secondDetails.legacyID {
+		t.Fatalf("Colliding slugs were not disambiguated: %q, %q", firstDetails.ID(), secondDetails.ID())
+	}
+	if cache.AddRepo(first) != firstDetails {
+		t.Fatal("Adding a repository again changed its slug")
+	}
+
+	// Old links that use the legacy ID still work, by redirecting to the slug.
+	handler := cache.RedirectLegacyIDs(http.HandlerFunc(cache.ServeRepoSummaryJSON))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/repo_summary?repo="+firstDetails.legacyID, nil))
+	if w.Code != http.StatusPermanentRedirect || w.Header().Get("Location") != "?repo=app" {
+		t.Fatalf("Unexpected response for a legacy ID: %d, %q", w.Code, w.Header().Get("Location"))
+	}
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/repo_summary?repo=app", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Unexpected response for a slug: %d, %q", w.Code, w.Body.String())
+	}
+	if details, ok := cache.lookup(secondDetails.legacyID); !ok || details != secondDetails {
+		t.Fatal("Unable to look up a repository by its legacy ID")
+	}
+
+	// Repositories found by scanning are identified by their path relative to the root.
+	cache = NewRepoCache()
+	cache.Scan([]ScanRoot{{Path: root}}, 2, NewGitRepo)
+	for _, slug := range []string{"team-app", "other-app"} {
+		if _, ok := cache.lookup(slug); !ok {
+			t.Errorf("Missing the repository with the slug %q", slug)
+		}
+	}
+}
+
+func TestDiscoveredSlugsDoNotDependOnOrder(t *testing.T) {
+	root := newTestTree(t, []string{"a/app", "b/app"}, []string{"a/app", "b/app"})
+	var paths []string
+	for _, dir := range []string{"a", "b"} {
+		paths = append(paths, filepath.Join(root, dir, "app"))
+	}
+	for _, order := range [][]int{{0, 1}, {1, 0}} {
+		cache := NewRepoCache()
+		for _, i := range order {
+			// Each repository is found under a root of its own, so both have the slug "app".
+			cache.Scan([]ScanRoot{{Path: paths[i]}}, 1, NewGitRepo)
+		}
+		repoDetails, ok := cache.lookup("app")
+		if !ok || repoDetails.Repo.GetPath() != paths[0] {
+			t.Fatalf("The slug \"app\" was not given to %q when the repositories were found in the order %v", paths[0], order)
+		}
+		for _, other := range cache.list() {
+			if other != repoDetails && other.ID() != "app-"+other.legacyID {
+				t.Fatalf("Unexpected slug %q when the repositories were found in the order %v", other.ID(), order)
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/google/git-appraise/repository"
+	"github.com/google/git-appraise/review"
+	"github.com/google/git-appraise/review/request"
+)
+
+const (
+	// The version of the format used to store review indexes.
+	//
+	// This must be incremented whenever the format changes, so that indexes stored
+	// by older versions of the server are ignored rather than misread.
+	indexStoreVersion = 1
+)
+
+// IndexStore persists the review indexes of repositories to a local directory.
+//
+// This lets a restarted server serve the reviews it had already read, rather than
+// reading every review from git again.
+type IndexStore struct {
+	dir string
+}
+
+// NewIndexStore constructs an IndexStore that keeps its data in the given directory,
+// creating the directory if it does not already exist.
+func NewIndexStore(dir string) (*IndexStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &IndexStore{dir: dir}, nil
+}
+
+// storedReview is the stored form of a review summary.
+type storedReview struct {
+	review.Summary
+	// AllRequests is omitted from the JSON encoding of a summary, so it has to be stored separately.
+	AllRequests []request.Request `json:"allRequests,omitempty"`
+}
+
+// storedIndex is the stored form of a review index.
+type storedIndex struct {
+	Version   int    `json:"version"`
+	Path      string `json:"path"`
+	RepoState string `json:"repoState"`
+	// Refs includes the commits of the notes refs that the reviews were read from.
+	Refs          map[string]string `json:"refs,omitempty"`
+	OpenReviews   []storedReview    `json:"openReviews"`
+	ClosedReviews []storedReview    `json:"closedReviews"`
+}
+
+// indexFile returns the name of the file that holds the index of the repository at the given path.
+func (store *IndexStore) indexFile(path string) string {
+	return filepath.Join(store.dir, fmt.Sprintf("%x.json", sha1.Sum([]byte(path))))
+}
+
+func storeReviews(summaries []review.Summary) []storedReview {
+	reviews := []storedReview{}
+	for _, summary := range summaries {
+		reviews = append(reviews, storedReview{Summary: summary, AllRequests: summary.AllRequests})
+	}
+	return reviews
+}
+
+// load reads the stored index of the given repository.
+//
+// If there is no usable stored index, then this returns nil. The returned index has to
+// be validated against the current state of the repository before it is served.
+func (store *IndexStore) load(repo repository.Repo) (*reviewIndex, error) {
+	contents, err := ioutil.ReadFile(store.indexFile(repo.GetPath()))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stored storedIndex
+	if err := json.Unmarshal(contents, &stored); err != nil {
+		return nil, err
+	}
+	if stored.Version != indexStoreVersion || stored.Path != repo.GetPath() {
+		return nil, nil
+	}
+	reviews := make(map[string]review.Summary)
+	for _, storedReviews := range [][]storedReview{stored.OpenReviews, stored.ClosedReviews} {
+		for _, storedReview := range storedReviews {
+			summary := storedReview.Summary
+			summary.Repo = repo
+			summary.AllRequests = storedReview.AllRequests
+			reviews[summary.Revision] = summary
+		}
+	}
+	return buildReviewIndex(stored.RepoState, stored.Refs, reviews), nil
+}
+
+// save stores the given index of the repository at the given path, replacing any previously stored index.
+func (store *IndexStore) save(path string, index *reviewIndex) error {
+	contents, err := json.Marshal(&storedIndex{
+		Version:       indexStoreVersion,
+		Path:          path,
+		RepoState:     index.repoState,
+		Refs:          index.refs,
+		OpenReviews:   storeReviews(index.openReviews),
+		ClosedReviews: storeReviews(index.closedReviews),
+	})
+	if err != nil {
+		return err
+	}
+	// The index is written to a temporary file first, so that a crash never leaves a partially written index behind.
+	tempFile, err := ioutil.TempFile(store.dir, ".index-")
+	if err != nil {
+		return err
+	}
+	if _, err := tempFile.Write(contents); err != nil {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+	if err := os.Rename(tempFile.Name(), store.indexFile(path)); err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+	return nil
+}
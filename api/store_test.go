@@ -0,0 +1,152 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+)
+
+func newTestIndexStore(t *testing.T) *IndexStore {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "git-appraise-web-index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	store, err := NewIndexStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+// newStoredRepoDetails simulates starting the server by reading the given repository using the given store.
+func newStoredRepoDetails(t *testing.T, dir string, store *IndexStore) (*RepoDetails, *notesCountingRepo) {
+	t.Helper()
+	baseRepo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &notesCountingRepo{gitRepo: baseRepo.(*gitRepo)}
+	repoDetails := NewRepoDetails(repo)
+	repoDetails.store = store
+	return repoDetails, repo
+}
+
+func TestIndexStore(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	store := newTestIndexStore(t)
+
+	repoDetails, repo := newStoredRepoDetails(t, dir, store)
+	if _, err := repoDetails.update(); err != nil {
+		t.Fatal(err)
+	}
+	if repo.getAllNotesCalls != 2 {
+		t.Fatalf("Unexpected number of reads of the notes: %d", repo.getAllNotesCalls)
+	}
+
+	// The stored index is served as-is when the repository has not changed.
+	repoDetails, repo = newStoredRepoDetails(t, dir, store)
+	index, err := repoDetails.update()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.getAllNotesCalls != 0 {
+		t.Fatalf("The stored index was not used")
+	}
+	if index.openReviewCount != 1 {
+		t.Fatalf("Unexpected stored index: %+v", index)
+	}
+	summary := index.openReviews[0]
+	if summary.Repo != repo || len(summary.AllRequests) != 1 || summary.Request.Description != "Feature" {
+		t.Fatalf("Unexpected stored review: %+v", summary)
+	}
+
+	// The stored index is updated when the repository changed while the server was stopped.
+	runTestGit(t, dir, "merge", "-q", "--ff-only", "feature")
+	repoDetails, repo = newStoredRepoDetails(t, dir, store)
+	index, err = repoDetails.update()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.getAllNotesCalls != 0 {
+		t.Fatalf("The stored index was not updated incrementally")
+	}
+	if index.openReviewCount != 0 || index.closedReviewCount != 1 {
+		t.Fatalf("Unexpected updated index: %+v", index)
+	}
+	checkIndexMatchesFullRebuild(t, repo.gitRepo, index)
+
+	stored, err := store.load(repo)
+	if err != nil || stored == nil || stored.repoState != index.repoState || stored.closedReviewCount != 1 {
+		t.Fatalf("The updated index was not stored: %+v, %v", stored, err)
+	}
+
+	// Stored indexes from other versions of the server are ignored.
+	if err := ioutil.WriteFile(store.indexFile(repo.GetPath()), []byte(`{"version":0}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if stored, err := store.load(repo); stored != nil || err != nil {
+		t.Fatalf("Unexpected result of loading an old index: %+v, %v", stored, err)
+	}
+}
+
+func TestIndexStoreConcurrentUpdates(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	store := newTestIndexStore(t)
+	repoDetails, repo := newStoredRepoDetails(t, dir, store)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		// Each update sees a new repository state, so that each one stores its index.
+		runTestGit(t, dir, "update-ref", fmt.Sprintf("refs/heads/branch%d", i), "master")
+		for j := 0; j < 3; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := repoDetails.update(); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	index, err := repoDetails.update()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := store.load(repo)
+	if err != nil || stored == nil || stored.repoState != index.repoState {
+		t.Fatalf("The latest index was not stored: %+v, %v", stored, err)
+	}
+
+	// The reviews of a repository that is gone are not stored.
+	runTestGit(t, dir, "update-ref", "refs/heads/gone", "master")
+	repoDetails.markGone()
+	if _, err := repoDetails.update(); err != nil {
+		t.Fatal(err)
+	}
+	if stored, err := store.load(repo); err != nil || stored == nil || stored.repoState != index.repoState {
+		t.Fatalf("The index of a repository that is gone was stored: %+v, %v", stored, err)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/git-appraise/repository"
+	"github.com/google/git-appraise/review"
+)
+
+// TimeoutError reports that an operation was abandoned because the request it was
+// running on behalf of timed out or was cancelled.
+type TimeoutError struct {
+	// Op describes the operation that was abandoned.
+	Op  string
+	Err error
+}
+
+func (err *TimeoutError) Error() string {
+	return fmt.Sprintf("Timed out while %s: %v", err.Op, err.Err)
+}
+
+func (err *TimeoutError) Unwrap() error {
+	return err.Err
+}
+
+// checkContext converts the given error into a TimeoutError if the given context is done.
+//
+// This is needed because the git-appraise libraries do not always pass errors through unchanged.
+func checkContext(ctx context.Context, op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return err
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return &TimeoutError{Op: op, Err: ctxErr}
+	}
+	return err
+}
+
+// serveError writes the given error to the given writer.
+//
+// The given status code is used, unless the error is a TimeoutError, in which case
+// the status is "504 Gateway Timeout".
+func serveError(w http.ResponseWriter, err error, code int) {
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		code = http.StatusGatewayTimeout
+	}
+	http.Error(w, err.Error(), code)
+}
+
+// WithTimeout wraps the given handler so that the context of every request has the given deadline.
+//
+// A timeout of zero means that no deadline is added.
+func WithTimeout(timeout time.Duration, h http.Handler) http.Handler {
+	if timeout <= 0 {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// contextRepo is implemented by Repos that can be bound to a context, so that
+// the git commands they run are killed once the context is done.
+type contextRepo interface {
+	WithContext(ctx context.Context) repository.Repo
+}
+
+// repoWithContext binds the given repo to the given context, if the repo supports that.
+func repoWithContext(ctx context.Context, repo repository.Repo) repository.Repo {
+	if ctxRepo, ok := repo.(contextRepo); ok {
+		return ctxRepo.WithContext(ctx)
+	}
+	return repo
+}
+
+// reviewWithContext returns a copy of the given review whose repo is bound to the given context.
+func reviewWithContext(ctx context.Context, r *review.Review) *review.Review {
+	summary := *r.Summary
+	summary.Repo = repoWithContext(ctx, summary.Repo)
+	return &review.Review{
+		Summary:  &summary,
+		Reports:  r.Reports,
+		Analyses: r.Analyses,
+	}
+}
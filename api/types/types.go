@@ -0,0 +1,131 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package types defines the types that the API writes as JSON.
+//
+// They are kept apart from the server, so that clients of the API can decode its responses
+// without depending on the server's implementation.
+package types
+
+import (
+	"time"
+
+	"github.com/google/git-appraise/repository"
+	"github.com/google/git-appraise/review"
+)
+
+// RepoMetadata describes a repository to the people browsing it.
+type RepoMetadata struct {
+	// Name is the display name of the repository. This defaults to the name of its directory.
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// RepoInfo describes the current state of a repository and its reviews.
+type RepoInfo struct {
+	// Branch is the branch checked out at HEAD. This is empty if HEAD is detached.
+	Branch string `json:"branch,omitempty"`
+	// Head is the commit at HEAD. This is empty if the repository has no commits.
+	Head string `json:"head,omitempty"`
+	// LatestActivity is the time of the most recent review request or comment.
+	LatestActivity    *time.Time `json:"latestActivity,omitempty"`
+	OpenReviewCount   int        `json:"openReviewCount"`
+	ClosedReviewCount int        `json:"closedReviewCount"`
+	// AwaitingActionCount is the number of open reviews that no reviewer has accepted or rejected yet.
+	AwaitingActionCount int `json:"awaitingActionCount"`
+	// ReadmeTitle is the title of the README at HEAD, or its first line if it has no title.
+	ReadmeTitle string `json:"readmeTitle,omitempty"`
+}
+
+// SyncStatus reports how a mirrored repository last synced with its upstream.
+type SyncStatus struct {
+	Upstream string `json:"upstream"`
+	// LastAttempt is the last time that the repository was synced, whether or not that succeeded.
+	LastAttempt *time.Time `json:"lastAttempt,omitempty"`
+	// LastSuccess is the last time that the repository was successfully synced.
+	LastSuccess *time.Time `json:"lastSuccess,omitempty"`
+	// Error is the error from the most recent sync, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// RepoListItem represents one entry in the result of calling the API to list repositories.
+type RepoListItem struct {
+	ID   string `json:"id"`
+	Path string `json:"path"`
+	RepoMetadata
+	// RepoInfo describes the repository as of the last time it was read, and is nil if it has not been read yet.
+	*RepoInfo
+}
+
+// ReposList is the return type for the API to list repositories.
+type ReposList []*RepoListItem
+
+func (repos ReposList) Len() int      { return len(repos) }
+func (repos ReposList) Swap(i, j int) { repos[i], repos[j] = repos[j], repos[i] }
+func (repos ReposList) Less(i, j int) bool {
+	return repos[i].ID < repos[j].ID
+}
+
+// RepoSummary is the return type for the API to summarize a repository.
+type RepoSummary struct {
+	ID   string `json:"id"`
+	Path string `json:"path"`
+	RepoMetadata
+	RepoInfo
+	// Sync reports how the repository last synced with its upstream, if it is a mirror.
+	Sync *SyncStatus `json:"sync,omitempty"`
+}
+
+// ScanProgress reports the progress of discovering the repositories to serve.
+type ScanProgress struct {
+	Scanning bool `json:"scanning"`
+	// CompletedScans counts the scans that have finished, including any earlier scans.
+	CompletedScans     int        `json:"completedScans"`
+	DirectoriesScanned int        `json:"directoriesScanned"`
+	ReposFound         int        `json:"reposFound"`
+	StartTime          *time.Time `json:"startTime,omitempty"`
+	EndTime            *time.Time `json:"endTime,omitempty"`
+}
+
+// CommitOverview encapsulates the fine-grained details of a commit.
+type CommitOverview struct {
+	ID      string                    `json:"id"`
+	Details *repository.CommitDetails `json:"details"`
+}
+
+// DiffSummary summarizes one of the diffs included in a review.
+//
+// This summary includes the list of all commits that can be used to construct such a diff.
+type DiffSummary struct {
+	ReviewCommits []CommitOverview `json:"reviewCommits,omitEmpty"`
+	LeftHandSide  string           `json:"leftHandSide"`
+	RightHandSide string           `json:"rightHandSide"`
+	Contents      string           `json:"contents"`
+}
+
+// ReviewListResponse represents a single `page` in a list of reviews.
+//
+// The next page is requested by passing the NextPageToken, which is empty on the last page.
+type ReviewListResponse struct {
+	Items         []review.Summary `json:"items"`
+	NextPageToken string           `json:"nextPageToken,omitEmpty"`
+}
+
+// ErrorResponse is the body of every unsuccessful response from the v2 API.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
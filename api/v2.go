@@ -0,0 +1,285 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/git-appraise-web/api/types"
+	"github.com/google/git-appraise/review"
+)
+
+// The statuses that the reviews listed by the v2 API can be selected by.
+const (
+	reviewStatusOpen   = "open"
+	reviewStatusClosed = "closed"
+)
+
+// ErrorResponse is the body of every unsuccessful response from the v2 API.
+type ErrorResponse = types.ErrorResponse
+
+// serveV2Error writes the given error to the given writer as an ErrorResponse.
+//
+// As with serveError, the given status code is replaced by "504 Gateway Timeout" for a TimeoutError.
+func serveV2Error(w http.ResponseWriter, err error, code int) {
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		code = http.StatusGatewayTimeout
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(&ErrorResponse{Error: err.Error()})
+}
+
+func serveV2JSON(v interface{}, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	serveJSON(v, w)
+}
+
+// lookupErrorCode returns the status code for the given error from looking up a repository or review.
+//
+// Only a request that does not name a repository or review correctly is a bad request. Any other
+// error, such as a failure to run git, is the server's.
+func lookupErrorCode(err error) int {
+	switch err {
+	case errNoRepo, errInvalidRepo, errInvalidRepoCharacter, errInvalidHash, errInvalidHashCharacter:
+		return http.StatusBadRequest
+	case errUnknownRepo, errUnknownReview:
+		return http.StatusNotFound
+	case errRepoGone:
+		return http.StatusGone
+	}
+	return http.StatusInternalServerError
+}
+
+// V2Handler returns the handler for version 2 of the API, which is served under the "/api/v2" path.
+//
+// Unlike the original API, where every endpoint takes the repository and review as URL
+// parameters, the v2 API identifies them by the path of the resource:
+//
+//	GET /repos                                      the list of repositories
+//	GET /repos/{repo}                               the summary of a repository
+//	GET /repos/{repo}/reviews                       a page of the repository's reviews
+//	GET /repos/{repo}/reviews/{review}              the details of a review
+//	GET /repos/{repo}/reviews/{review}/diff         the diff of a review
+//	GET /repos/{repo}/lookup?ref={ref}              a redirect to the review identified by a ref
+//	GET /repos/{repo}/commits/{commit}/files/{path} the contents of a file at a commit
+//	GET /scan                                       the progress of the scan for repositories
+//	GET /openapi.json                               the OpenAPI document describing the API
+//
+// Unknown resources are reported with a "404 Not Found" status, and removed repositories with
+// a "410 Gone" status. Every error is reported with a JSON ErrorResponse. A review may also be
+// identified by a commit in it, its branch, or its alias or Change-Id, in which case the request
+// is redirected to the review's revision.
+func (cache *RepoCache) V2Handler() http.Handler {
+	return http.HandlerFunc(cache.serveV2)
+}
+
+func (cache *RepoCache) serveV2(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		serveV2Error(w, errors.New("Method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(path) == 1 && path[0] == "repos":
+		cache.serveV2Repos(w, r)
+		return
+	case len(path) == 1 && path[0] == "scan":
+		serveV2JSON(cache.GetScanProgress(), w)
+		return
+	case len(path) == 1 && path[0] == "openapi.json":
+		serveOpenAPIDocument(w, r)
+		return
+	case len(path) < 2 || path[0] != "repos":
+		serveV2Error(w, errors.New("Not found"), http.StatusNotFound)
+		return
+	}
+	repoDetails, err := cache.findRepo(path[1])
+	if err != nil {
+		serveV2Error(w, err, lookupErrorCode(err))
+		return
+	}
+	resource := path[2:]
+	switch {
+	case len(resource) == 0:
+		serveV2RepoSummary(w, repoDetails)
+	case len(resource) == 1 && resource[0] == "reviews":
+		serveV2Reviews(w, r, repoDetails)
+	case len(resource) == 1 && resource[0] == "lookup":
+		serveV2ReviewLookup(w, r, repoDetails)
+	case len(resource) == 2 && resource[0] == "reviews":
+		serveV2Review(w, r, repoDetails, resource[1])
+	case len(resource) == 3 && resource[0] == "reviews" && resource[2] == "diff":
+		serveV2ReviewDiff(w, r, repoDetails, resource[1])
+	case len(resource) >= 4 && resource[0] == "commits" && resource[2] == "files":
+		serveV2FileContents(w, r, repoDetails, resource[1], strings.Join(resource[3:], "/"))
+	default:
+		serveV2Error(w, errors.New("Not found"), http.StatusNotFound)
+	}
+}
+
+// serveV2Repos writes the list of repositories, in the order given by the 'sort' URL parameter.
+func (cache *RepoCache) serveV2Repos(w http.ResponseWriter, r *http.Request) {
+	reposList := ReposList{}
+	for _, repoDetails := range cache.list() {
+		reposList = append(reposList, repoDetails.GetListItem())
+	}
+	if err := sortRepos(reposList, r.URL.Query().Get("sort")); err != nil {
+		serveV2Error(w, err, http.StatusBadRequest)
+		return
+	}
+	serveV2JSON(reposList, w)
+}
+
+func serveV2RepoSummary(w http.ResponseWriter, repoDetails *RepoDetails) {
+	summary, err := repoDetails.GetSummary()
+	if err != nil {
+		serveV2Error(w, err, http.StatusInternalServerError)
+		return
+	}
+	serveV2JSON(summary, w)
+}
+
+// serveV2Reviews writes a page of the repository's reviews.
+//
+// The 'status' URL parameter selects the "open" (the default) or "closed" reviews, and the page
+// is selected by the URL parameters read by parseReviewListQuery.
+func serveV2Reviews(w http.ResponseWriter, r *http.Request, repoDetails *RepoDetails) {
+	query, err := parseReviewListQuery(r.URL.Query())
+	if err != nil {
+		serveV2Error(w, err, http.StatusBadRequest)
+		return
+	}
+	var reviews *ReviewListResponse
+	switch r.URL.Query().Get("status") {
+	case "", reviewStatusOpen:
+		reviews, err = repoDetails.GetOpenReviews(r.Context(), query)
+	case reviewStatusClosed:
+		reviews, err = repoDetails.GetClosedReviews(r.Context(), query)
+	default:
+		serveV2Error(w, errors.New("Invalid status parameter"), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		serveV2Error(w, err, http.StatusInternalServerError)
+		return
+	}
+	serveV2JSON(reviews, w)
+}
+
+// getV2Review loads the review with the given ID, writing an error response if that fails.
+//
+// An ID that is not the revision of a review, but which FindReview resolves to one, is redirected
+// to the same resource of that review. The given suffix is the rest of the resource's path after the ID.
+func getV2Review(w http.ResponseWriter, r *http.Request, repoDetails *RepoDetails, reviewID, suffix string) (*review.Review, bool) {
+	if repoDetails.checkHash(reviewID) == nil && len(reviewID) == repoDetails.getHashLength() {
+		reviewDetails, err := repoDetails.GetReview(r.Context(), reviewID)
+		if err == nil {
+			return reviewDetails, true
+		}
+		if err != errUnknownReview {
+			serveV2Error(w, err, lookupErrorCode(err))
+			return nil, false
+		}
+	}
+	revision, err := repoDetails.FindReview(r.Context(), reviewID)
+	if err == nil && revision == reviewID {
+		err = errUnknownReview
+	}
+	if err != nil {
+		serveV2Error(w, err, lookupErrorCode(err))
+		return nil, false
+	}
+	redirectV2Review(w, r, strings.Repeat("../", strings.Count(suffix, "/"))+url.PathEscape(revision)+suffix)
+	return nil, false
+}
+
+// redirectV2Review redirects to the given location, relative to the requested path, keeping the URL parameters.
+//
+// The review that a branch or commit belongs to can change, so the redirect is not permanent.
+func redirectV2Review(w http.ResponseWriter, r *http.Request, location string) {
+	if r.URL.RawQuery != "" {
+		location += "?" + r.URL.RawQuery
+	}
+	w.Header().Set("Location", location)
+	w.WriteHeader(http.StatusTemporaryRedirect)
+}
+
+func serveV2Review(w http.ResponseWriter, r *http.Request, repoDetails *RepoDetails, reviewID string) {
+	if reviewDetails, ok := getV2Review(w, r, repoDetails, reviewID, ""); ok {
+		serveV2JSON(reviewDetails, w)
+	}
+}
+
+// serveV2ReviewLookup redirects to the review identified by the 'ref' URL parameter, which is
+// resolved by FindReview. Unlike a reference in the path of a review, this may contain slashes.
+func serveV2ReviewLookup(w http.ResponseWriter, r *http.Request, repoDetails *RepoDetails) {
+	revision, err := repoDetails.FindReview(r.Context(), r.URL.Query().Get("ref"))
+	if err != nil {
+		serveV2Error(w, err, lookupErrorCode(err))
+		return
+	}
+	w.Header().Set("Location", "reviews/"+url.PathEscape(revision))
+	w.WriteHeader(http.StatusTemporaryRedirect)
+}
+
+// serveV2ReviewDiff writes the diff of a review between the commits given by the 'lhs' and
+// 'rhs' URL parameters, which default to the review's base and head commits.
+func serveV2ReviewDiff(w http.ResponseWriter, r *http.Request, repoDetails *RepoDetails, reviewID string) {
+	lhs := r.URL.Query().Get("lhs")
+	rhs := r.URL.Query().Get("rhs")
+	if err := repoDetails.checkHash(lhs); err != nil {
+		serveV2Error(w, errors.New("Invalid lhs parameter"), http.StatusBadRequest)
+		return
+	}
+	if err := repoDetails.checkHash(rhs); err != nil {
+		serveV2Error(w, errors.New("Invalid rhs parameter"), http.StatusBadRequest)
+		return
+	}
+	reviewDetails, ok := getV2Review(w, r, repoDetails, reviewID, "/diff")
+	if !ok {
+		return
+	}
+	diffSummary, err := NewDiffSummary(r.Context(), reviewDetails, lhs, rhs)
+	if err != nil {
+		serveV2Error(w, err, http.StatusInternalServerError)
+		return
+	}
+	serveV2JSON(diffSummary, w)
+}
+
+// serveV2FileContents writes the contents of the file at the given path as of the given commit.
+func serveV2FileContents(w http.ResponseWriter, r *http.Request, repoDetails *RepoDetails, commit, path string) {
+	if commit == "" || repoDetails.checkHash(commit) != nil {
+		serveV2Error(w, errors.New("Invalid commit"), http.StatusBadRequest)
+		return
+	}
+	contents, err := repoWithContext(r.Context(), repoDetails.Repo).Show(commit, path)
+	if err != nil {
+		serveV2Error(w, checkContext(r.Context(), "reading the file contents", errors.New("No such file")), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write([]byte(contents))
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestV2Resources(t *testing.T) {
+	dir := newTestGitDir(t)
+	addTestReview(t, dir)
+	feature := runTestGit(t, dir, "rev-parse", "feature")
+	head := runTestGit(t, dir, "rev-parse", "HEAD")
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewRepoCache()
+	repoDetails := cache.AddConfiguredRepo(repo, RepoConfig{Slug: "test"})
+	goneRepo, err := NewGitRepo(newTestGitDir(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.AddConfiguredRepo(goneRepo, RepoConfig{Slug: "gone"}).markGone()
+	// A repository that can no longer be read is a failure of the server, rather than of the request.
+	brokenDir := newTestGitDir(t)
+	brokenRepo, err := NewGitRepo(brokenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.AddConfiguredRepo(brokenRepo, RepoConfig{Slug: "broken"})
+	if err := os.RemoveAll(brokenDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := repoDetails.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	handler := http.StripPrefix("/api/v2", cache.V2Handler())
+
+	for _, tc := range []struct {
+		method, url string
+		code        int
+		contains    string
+	}{
+		{"GET", "/api/v2/repos", http.StatusOK, `"id": "test"`},
+		{"GET", "/api/v2/repos?sort=size", http.StatusBadRequest, "Invalid sort order"},
+		{"GET", "/api/v2/repos/test", http.StatusOK, `"openReviewCount": 1`},
+		{"GET", "/api/v2/repos/missing", http.StatusNotFound, "Invalid repository"},
+		{"GET", "/api/v2/repos/gone/reviews", http.StatusGone, "removed"},
+		{"GET", "/api/v2/repos/broken/lookup?ref=feature", http.StatusInternalServerError, ""},
+		{"GET", "/api/v2/repos/bad*slug", http.StatusBadRequest, "Invalid repository character"},
+		{"GET", "/api/v2/repos/test/reviews", http.StatusOK, feature},
+		{"GET", "/api/v2/repos/test/reviews?status=closed", http.StatusOK, `"items": []`},
+		{"GET", "/api/v2/repos/test/reviews?status=merged", http.StatusBadRequest, "Invalid status"},
+		{"GET", "/api/v2/repos/test/reviews/" + feature, http.StatusOK, `"revision": "` + feature + `"`},
+		{"GET", "/api/v2/repos/test/reviews/" + head, http.StatusNotFound, "Invalid review"},
+		{"GET", "/api/v2/repos/test/reviews/not-a-hash", http.StatusNotFound, "Invalid review"},
+		{"GET", "/api/v2/repos/test/reviews/" + feature + "/diff", http.StatusOK, `"contents"`},
+		{"GET", "/api/v2/repos/test/reviews/" + feature + "/diff?lhs=xyz", http.StatusBadRequest, "Invalid lhs"},
+		{"GET", "/api/v2/repos/test/commits/" + head + "/files/README.md", http.StatusOK, "Test repo"},
+		{"GET", "/api/v2/repos/test/commits/" + head + "/files/missing.txt", http.StatusNotFound, "No such file"},
+		{"GET", "/api/v2/repos/test/branches", http.StatusNotFound, "Not found"},
+		{"GET", "/api/v2/scan", http.StatusOK, `"scanning"`},
+		{"DELETE", "/api/v2/repos/test", http.StatusMethodNotAllowed, "Method not allowed"},
+	} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(tc.method, tc.url, nil))
+		if w.Code != tc.code || !strings.Contains(w.Body.String(), tc.contains) {
+			t.Errorf("Unexpected response for %s %s: %d %q", tc.method, tc.url, w.Code, w.Body.String())
+			continue
+		}
+		if w.Code >= 400 {
+			var response ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil || response.Error == "" {
+				t.Errorf("Unexpected error response for %s %s: %q", tc.method, tc.url, w.Body.String())
+			}
+		}
+	}
+}
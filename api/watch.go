@@ -0,0 +1,161 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"log"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// How long to wait after a ref changes before refreshing, so that a burst of
+	// ref updates (e.g. from a fetch) results in a single refresh.
+	refreshDebounce = 100 * time.Millisecond
+)
+
+// refsWatcher reports changes to the refs of a repository.
+type refsWatcher interface {
+	// Changes returns a channel that receives a value whenever the refs may have changed.
+	//
+	// The channel is closed once the watcher stops.
+	Changes() <-chan struct{}
+	// Close stops the watcher.
+	Close() error
+}
+
+// gitCommonDir returns the directory holding the refs of the repository at the given path.
+func gitCommonDir(path string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--git-common-dir")
+	cmd.Dir = path
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	dir := strings.TrimSpace(string(out))
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(path, dir)
+	}
+	return dir, nil
+}
+
+// refreshSettings describes how repositories are refreshed in the background.
+type refreshSettings struct {
+	watch        bool
+	pollInterval time.Duration
+}
+
+// RefreshInBackground keeps every repository in the cache up to date in the background.
+//
+// If watch is set, then each repository's refs are watched, and the repository is
+// refreshed whenever they change. Repositories that are not watched are instead
+// polled at the given interval. Once a repository is refreshed in the background,
+// requests are served from its latest snapshot without checking for changes first.
+//
+// Repositories that are neither watched nor polled are checked for changes at the start
+// of every request. Repositories added to the cache later are refreshed in the same way.
+func (cache *RepoCache) RefreshInBackground(watch bool, pollInterval time.Duration) {
+	cache.mu.Lock()
+	cache.refresh = &refreshSettings{watch: watch, pollInterval: pollInterval}
+	cache.mu.Unlock()
+	for _, repoDetails := range cache.list() {
+		go repoDetails.refreshInBackground(watch, pollInterval)
+	}
+}
+
+func (details *RepoDetails) watch() (refsWatcher, error) {
+	gitDir, err := gitCommonDir(details.Repo.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	return watchRefs(gitDir)
+}
+
+func (details *RepoDetails) setBackgroundRefresh(enabled bool) {
+	details.mu.Lock()
+	defer details.mu.Unlock()
+	details.backgroundRefresh = enabled
+}
+
+func (details *RepoDetails) refreshInBackground(watch bool, pollInterval time.Duration) {
+	var changes <-chan struct{}
+	if watch {
+		watcher, err := details.watch()
+		if err != nil {
+			log.Printf("Unable to watch %q for changes: %v", details.Repo.GetPath(), err)
+		} else {
+			defer watcher.Close()
+			changes = watcher.Changes()
+		}
+	}
+	details.refreshOnChanges(changes, pollInterval)
+}
+
+// refreshOnChanges refreshes the repository whenever the given channel receives a value,
+// or at the given interval if the channel is nil.
+//
+// If the channel is closed, then the repository is polled from then on instead.
+func (details *RepoDetails) refreshOnChanges(changes <-chan struct{}, pollInterval time.Duration) {
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	var ticks <-chan time.Time
+	if changes == nil {
+		if pollInterval <= 0 {
+			return
+		}
+		ticker = time.NewTicker(pollInterval)
+		ticks = ticker.C
+	}
+
+	// This picks up any changes made before the watcher started.
+	details.refresh()
+	details.setBackgroundRefresh(true)
+	for {
+		select {
+		case <-details.stop:
+			return
+		case _, ok := <-changes:
+			if !ok {
+				log.Printf("Stopped watching %q for changes", details.Repo.GetPath())
+				changes = nil
+				if pollInterval <= 0 {
+					details.setBackgroundRefresh(false)
+					return
+				}
+				// Fall back to polling, which first picks up any changes the watcher missed.
+				ticker = time.NewTicker(pollInterval)
+				ticks = ticker.C
+				details.refresh()
+				continue
+			}
+			time.Sleep(refreshDebounce)
+			select {
+			case <-changes:
+			default:
+			}
+			details.refresh()
+		case <-ticks:
+			details.refresh()
+		}
+	}
+}
@@ -0,0 +1,180 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	// The inotify events that indicate a ref may have been created, updated, or deleted.
+	//
+	// Git updates refs by renaming a lock file over them, so the interesting events are
+	// the ones for entries appearing in, or disappearing from, a directory.
+	refsWatchMask = syscall.IN_CREATE | syscall.IN_MOVED_TO | syscall.IN_MOVED_FROM |
+		syscall.IN_DELETE | syscall.IN_CLOSE_WRITE | syscall.IN_DELETE_SELF
+)
+
+// inotifyWatcher watches a repository's refs using the Linux inotify API.
+//
+// Inotify watches are not recursive, so the watcher adds a watch for every directory
+// under "refs/", including ones that are created after the watcher starts.
+type inotifyWatcher struct {
+	fd      int
+	file    *os.File
+	gitDir  string
+	dirs    map[int32]string
+	changes chan struct{}
+}
+
+// watchRefs starts watching the HEAD, refs and packed-refs of the repository with the given git directory.
+func watchRefs(gitDir string) (refsWatcher, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+	watcher := &inotifyWatcher{
+		fd: fd,
+		// Wrapping the non-blocking descriptor in a File lets reads use the runtime's poller,
+		// which means that closing the file interrupts a pending read.
+		file:    os.NewFile(uintptr(fd), "inotify"),
+		gitDir:  gitDir,
+		dirs:    make(map[int32]string),
+		changes: make(chan struct{}, 1),
+	}
+	if err := watcher.addDir(gitDir); err != nil {
+		watcher.file.Close()
+		return nil, err
+	}
+	if err := watcher.addTree(filepath.Join(gitDir, "refs")); err != nil {
+		watcher.file.Close()
+		return nil, err
+	}
+	go watcher.readEvents()
+	return watcher, nil
+}
+
+func (watcher *inotifyWatcher) addDir(dir string) error {
+	wd, err := syscall.InotifyAddWatch(watcher.fd, dir, refsWatchMask)
+	if err != nil {
+		return &os.PathError{Op: "inotify_add_watch", Path: dir, Err: err}
+	}
+	watcher.dirs[int32(wd)] = dir
+	return nil
+}
+
+// addTree watches the given directory and every directory beneath it.
+func (watcher *inotifyWatcher) addTree(root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path != root {
+				// The directory was removed while we were walking it.
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		return watcher.addDir(path)
+	})
+}
+
+// isRefChange reports whether the named entry in the given directory could be a ref.
+func (watcher *inotifyWatcher) isRefChange(dir, name string) bool {
+	if strings.HasSuffix(name, ".lock") {
+		return false
+	}
+	if dir == watcher.gitDir {
+		// HEAD changes when another branch is checked out, which changes the repository's info.
+		return name == "packed-refs" || name == "HEAD"
+	}
+	return true
+}
+
+func (watcher *inotifyWatcher) notify() {
+	select {
+	case watcher.changes <- struct{}{}:
+	default:
+		// A change notification is already pending.
+	}
+}
+
+func (watcher *inotifyWatcher) readEvents() {
+	defer close(watcher.changes)
+	buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+	for {
+		n, err := watcher.file.Read(buf)
+		if err != nil {
+			return
+		}
+		changed := false
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameBytes := buf[offset+syscall.SizeofInotifyEvent : offset+syscall.SizeofInotifyEvent+int(event.Len)]
+			name := string(bytes.TrimRight(nameBytes, "\x00"))
+			offset += syscall.SizeofInotifyEvent + int(event.Len)
+
+			if event.Mask&syscall.IN_Q_OVERFLOW != 0 {
+				// Some events were dropped, so we have to assume that anything could have changed.
+				changed = true
+				continue
+			}
+			dir, ok := watcher.dirs[event.Wd]
+			if !ok {
+				continue
+			}
+			if event.Mask&syscall.IN_IGNORED != 0 {
+				delete(watcher.dirs, event.Wd)
+				continue
+			}
+			if dir != watcher.gitDir && event.Mask&syscall.IN_ISDIR != 0 &&
+				event.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+				// Refs may have been written to the new directory before we started watching it.
+				watcher.addTree(filepath.Join(dir, name))
+				changed = true
+				continue
+			}
+			if event.Mask&syscall.IN_DELETE_SELF != 0 || watcher.isRefChange(dir, name) {
+				changed = true
+			}
+		}
+		if changed {
+			watcher.notify()
+		}
+	}
+}
+
+// Changes returns a channel that receives a value whenever the refs may have changed.
+//
+// The channel is closed once the watcher stops.
+func (watcher *inotifyWatcher) Changes() <-chan struct{} {
+	return watcher.changes
+}
+
+// Close stops the watcher.
+func (watcher *inotifyWatcher) Close() error {
+	return watcher.file.Close()
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func expectChange(t *testing.T, watcher refsWatcher, description string) {
+	t.Helper()
+	select {
+	case <-watcher.Changes():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("No change reported after %s", description)
+	}
+}
+
+func TestWatchRefs(t *testing.T) {
+	dir := newTestGitDir(t)
+	watcher, err := watchRefs(filepath.Join(dir, ".git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	runTestGit(t, dir, "update-ref", "refs/notes/devtools/reviews", "HEAD")
+	expectChange(t, watcher, "creating a ref in a new directory")
+	runTestGit(t, dir, "pack-refs", "--all")
+	expectChange(t, watcher, "packing refs")
+	runTestGit(t, dir, "commit", "-q", "--allow-empty", "-m", "Second commit")
+	expectChange(t, watcher, "updating a branch")
+}
+
+func TestBackgroundRefresh(t *testing.T) {
+	dir := newTestGitDir(t)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoDetails := NewRepoDetails(repo)
+	go repoDetails.refreshInBackground(true, 0)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		repoDetails.mu.Lock()
+		backgroundRefresh := repoDetails.backgroundRefresh
+		repoDetails.mu.Unlock()
+		if backgroundRefresh {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("The repository was never refreshed in the background")
+		}
+	}
+
+	addTestReview(t, dir)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		summary, err := repoDetails.GetSummary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.OpenReviewCount == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("The new review was never picked up: %+v", summary)
+		}
+	}
+}
+
+func TestBackgroundRefreshFallsBackToPolling(t *testing.T) {
+	dir := newTestGitDir(t)
+	repo, err := NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoDetails := NewRepoDetails(repo)
+	changes := make(chan struct{})
+	go repoDetails.refreshOnChanges(changes, 10*time.Millisecond)
+	close(changes)
+
+	addTestReview(t, dir)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		summary, err := repoDetails.GetSummary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		repoDetails.mu.Lock()
+		backgroundRefresh := repoDetails.backgroundRefresh
+		repoDetails.mu.Unlock()
+		if summary.OpenReviewCount == 1 && backgroundRefresh {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("The new review was never picked up after the watcher stopped: %+v", summary)
+		}
+	}
+}
@@ -16,6 +16,38 @@ limitations under the License.
 
 var gitAppraiseWeb=angular.module("gitAppraiseWeb", []);
 
+// API URLs are relative to the static pages, so that the UI keeps working
+// when the server is mounted under a path prefix.
+var apiRoot = "../api/";
+
+// The maximum number of times that an API request is retried after the server
+// rate-limits it.
+var maxRateLimitRetries = 5;
+
+// Retry API requests that the server rate-limited, once the server says that
+// they may be sent again. Large reviews read a file for every comment, which
+// can briefly exceed the server's rate limit.
+gitAppraiseWeb.config(function($httpProvider) {
+  $httpProvider.interceptors.push(function($q, $injector, $timeout) {
+    return {
+      responseError: function(rejection) {
+        var config = rejection.config;
+        if (rejection.status != 429 || (config.rateLimitRetries || 0) >= maxRateLimitRetries) {
+          return $q.reject(rejection);
+        }
+        config.rateLimitRetries = (config.rateLimitRetries || 0) + 1;
+        var delaySeconds = parseInt(rejection.headers("Retry-After"), 10);
+        if (isNaN(delaySeconds) || delaySeconds < 1) {
+          delaySeconds = 1;
+        }
+        return $timeout(function() {
+          return $injector.get("$http")(config);
+        }, delaySeconds * 1000);
+      }
+    };
+  });
+});
+
 // Get a repository name from the full path.
 function getLastPathElement(path) {
   var slashIndex = path.lastIndexOf("/");
@@ -38,36 +70,113 @@ function getSummary(desc) {
   return result;
 }
 
-gitAppraiseWeb.controller("listRepos", function($scope,$http) {
-  $http.get("/api/repos").success(
-    function(response) {$scope.repositories = processListReposResponse(response);});
+gitAppraiseWeb.controller("listRepos", function($scope,$http,$timeout,$location) {
+  $scope.sortOrders = [
+    {value: "name", label: "Name"},
+    {value: "activity", label: "Latest activity"},
+    {value: "open", label: "Open reviews"},
+    {value: "awaiting", label: "Awaiting action"}
+  ];
+  $scope.sort = $location.search()['sort'] || "name";
+  $scope.$watch("sort", function(sort, previous) {
+    if (sort != previous) {
+      $location.search("sort", sort).replace();
+      loadRepos();
+    }
+  });
+
+  // Repositories are listed as soon as they are found, so keep reloading the
+  // list until the server has finished scanning for them.
+  function loadRepos() {
+    $http.get(apiRoot + "scan_progress").success(function(progress) {
+      $scope.scan = progress;
+      $http.get(apiRoot + "repos?sort=" + $scope.sort).success(
+        function(response) {$scope.repositories = processListReposResponse(response);});
+      if (progress.scanning) {
+        $timeout(loadRepos, 1000);
+      }
+    });
+  }
+  loadRepos();
 
   function processListReposResponse(response) {
     var repos = [];
     for (var i in response) {
-      var path = response[i].path;
-      repos.push(new Repo(response[i].id, getLastPathElement(path)));
+      repos.push(new Repo(response[i]));
     }
     return repos;
   }
 
-  function Repo(id, name) {
-    this.id = id;
-    this.name = name;
+  function Repo(item) {
+    this.id = item.id;
+    this.name = item.name || getLastPathElement(item.path);
+    this.description = item.description || item.readmeTitle || "";
+    this.tags = (item.tags || []).join(", ");
+    this.status = "";
+    if ("openReviewCount" in item) {
+      var status = [item.openReviewCount + " open", item.awaitingActionCount + " awaiting action",
+                    item.closedReviewCount + " closed"];
+      if (item.branch) {
+        status.unshift(item.branch + (item.head ? " @ " + item.head.substring(0, 7) : ""));
+      }
+      if (item.latestActivity) {
+        status.push("last active " + new Date(item.latestActivity).toLocaleString());
+      }
+      this.status = status.join(" \u00b7 ");
+    }
   }
 });
 
 gitAppraiseWeb.controller("listReviews", function($scope,$http,$location) {
   var repo = $location.search()['repo'];
   $scope.repo = repo;
-  $http.get("/api/repo_summary?repo=" + repo).success(
-    function(response) {$scope.path = getLastPathElement(response.path);});
-  listAllReviews("/api/open_reviews?repo=" + repo, function(response){
-    $scope.openReviews = response;
-  });
-  listAllReviews("/api/closed_reviews?repo=" + repo, function(response){
-    $scope.closedReviews = response;
+  $http.get(apiRoot + "repo_summary?repo=" + repo).success(
+    function(response) {
+      $scope.path = response.name || getLastPathElement(response.path);
+      // Links using the repository's old, hash-based ID are updated to use its slug.
+      if (response.id && response.id != repo) {
+        $scope.repo = response.id;
+        $location.search("repo", response.id).replace();
+      }
+    });
+  // The reviews are filtered by the server, so that finding a review does not require listing them all.
+  $scope.filter = {
+    description: $location.search()['description'] || "",
+    requester: $location.search()['requester'] || "",
+    reviewer: $location.search()['reviewer'] || "",
+    state: $location.search()['state'] || "",
+    path: $location.search()['path'] || ""
+  };
+  $scope.states = ["", "pending", "accepted", "rejected"];
+  $scope.sortOrders = [
+    {value: "created", label: "Newest"},
+    {value: "updated", label: "Latest activity"},
+    {value: "requester", label: "Requester"}
+  ];
+  $scope.sort = $location.search()['sort'] || "created";
+  $scope.$watch("sort", function(sort, previous) {
+    if (sort != previous) {
+      $location.search("sort", sort).replace();
+      $scope.search();
+    }
   });
+  $scope.search = function() {
+    var query = "&sort=" + $scope.sort;
+    for (var param in $scope.filter) {
+      var value = $scope.filter[param];
+      $location.search(param, value || null).replace();
+      if (value) {
+        query += "&" + param + "=" + encodeURIComponent(value);
+      }
+    }
+    listAllReviews(apiRoot + "open_reviews?repo=" + repo + query, function(response){
+      $scope.openReviews = response;
+    });
+    listAllReviews(apiRoot + "closed_reviews?repo=" + repo + query, function(response){
+      $scope.closedReviews = response;
+    });
+  };
+  $scope.search();
 
   function addPageItems(page, reviews) {
     for (var i in page.items) {
@@ -83,7 +192,7 @@ gitAppraiseWeb.controller("listReviews", function($scope,$http,$location) {
     function processor(response) {
       addPageItems(response, reviews);
       if (response.nextPageToken) {
-        $http.get(baseQuery + "&page=" + response.nextPageToken).success(processor);
+        $http.get(baseQuery + "&page=" + encodeURIComponent(response.nextPageToken)).success(processor);
       } else {
         callback(reviews);
       }
@@ -106,14 +215,20 @@ gitAppraiseWeb.controller("listReviews", function($scope,$http,$location) {
 gitAppraiseWeb.controller("getReview", function($scope,$http,$location) {
   var repo = $location.search()['repo'];
   var review = $location.search()['review'];
-  $http.get("/api/repo_summary?repo=" + repo).success(
-    function(response) {$scope.path = getLastPathElement(response.path);});
-  $http.get("/api/review_details?repo=" + repo + "&review=" + review).success(
+  $http.get(apiRoot + "repo_summary?repo=" + repo).success(
+    function(response) {
+      $scope.path = response.name || getLastPathElement(response.path);
+      // Links using the repository's old, hash-based ID are updated to use its slug.
+      if (response.id && response.id != repo) {
+        $location.search("repo", response.id).replace();
+      }
+    });
+  $http.get(apiRoot + "review_details?repo=" + repo + "&review=" + review).success(
     function(response) {
       $scope.details = response;
       loadSnippets(response.comments);
     });
-  $http.get("/api/review_diff?repo=" + repo + "&review=" + review).success(
+  $http.get(apiRoot + "review_diff?repo=" + repo + "&review=" + review).success(
     function(response) {$scope.diff = response;});
 
   function loadSnippets(commentThreads) {
@@ -153,7 +268,7 @@ gitAppraiseWeb.controller("getReview", function($scope,$http,$location) {
       for (var path in commitPaths) {
         var pathLines = commitPaths[path];
         var reader = snippetReader(path, commit, pathLines, commentThreads);
-        $http.get("/api/repo_contents?repo=" + repo + "&commit=" + commit + "&file=" + path).success(reader);
+        $http.get(apiRoot + "repo_contents?repo=" + repo + "&commit=" + commit + "&file=" + path).success(reader);
       }
     }
   }
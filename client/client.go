@@ -0,0 +1,289 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client is a Go client for the v2 API served by git-appraise-web.
+//
+// The API is described by the OpenAPI document served at "/api/v2/openapi.json".
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/git-appraise-web/api/types"
+	"github.com/google/git-appraise/review"
+)
+
+// Client makes requests to the API of a git-appraise-web server.
+type Client struct {
+	// BaseURL is the URL that the server is served at, such as "https://reviews.example.com",
+	// including any path prefix that the server is served under.
+	BaseURL string
+	// HTTPClient sends the requests. If this is nil, then http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// New constructs a client for the server at the given base URL.
+func New(baseURL string) *Client {
+	return &Client{BaseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// Error is returned for requests that the server responded to with an error.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", err.StatusCode, http.StatusText(err.StatusCode), err.Message)
+}
+
+// IsNotFound reports whether the given error is for a repository, review, or file that does not exist.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusGone)
+}
+
+// get sends a GET request for the given path under "/api/v2", and returns the body of a successful response.
+func (c *Client) get(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	u := c.BaseURL + "/api/v2" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		var errorResponse types.ErrorResponse
+		if json.Unmarshal(body, &errorResponse) == nil && errorResponse.Error != "" {
+			apiErr.Message = errorResponse.Error
+		} else {
+			apiErr.Message = strings.TrimSpace(string(body))
+		}
+		return nil, apiErr
+	}
+	return body, nil
+}
+
+// getJSON sends a GET request for the given path under "/api/v2", and decodes the response into v.
+func (c *Client) getJSON(ctx context.Context, path string, query url.Values, v interface{}) error {
+	body, err := c.get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func repoPath(repo string) string {
+	return "/repos/" + url.PathEscape(repo)
+}
+
+func reviewPath(repo, revision string) string {
+	return repoPath(repo) + "/reviews/" + url.PathEscape(revision)
+}
+
+// ListRepos lists the repositories in the given order, which is one of "id" (the default),
+// "name", "activity", "open", or "awaiting".
+func (c *Client) ListRepos(ctx context.Context, order string) (types.ReposList, error) {
+	query := url.Values{}
+	if order != "" {
+		query.Set("sort", order)
+	}
+	var repos types.ReposList
+	if err := c.getJSON(ctx, "/repos", query, &repos); err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+// GetRepo summarizes the repository with the given slug.
+func (c *Client) GetRepo(ctx context.Context, repo string) (*types.RepoSummary, error) {
+	var summary types.RepoSummary
+	if err := c.getJSON(ctx, repoPath(repo), nil, &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// GetScanProgress reports the progress of the server's scan for repositories.
+func (c *Client) GetScanProgress(ctx context.Context) (*types.ScanProgress, error) {
+	var progress types.ScanProgress
+	if err := c.getJSON(ctx, "/scan", nil, &progress); err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+// ReviewListOptions selects the reviews to list. The zero value lists the open reviews, newest first.
+type ReviewListOptions struct {
+	// Closed lists the closed reviews rather than the open ones.
+	Closed bool
+	// Order is one of "created" (the default), "updated", or "requester".
+	Order string
+	// PageSize is the maximum number of reviews in each page. Zero uses the server's default.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page. ListAllReviews ignores this.
+	PageToken string
+
+	Requester string
+	Reviewer  string
+	TargetRef string
+	// State is one of "accepted", "rejected", or "pending".
+	State string
+	// PathPrefix matches the reviews that change the file with the given path, or a file beneath it.
+	PathPrefix string
+	// Description matches the reviews whose description contains the given text, ignoring case.
+	Description string
+	// Zero times leave the corresponding range unbounded.
+	CreatedAfter, CreatedBefore time.Time
+	UpdatedAfter, UpdatedBefore time.Time
+}
+
+// values returns the URL parameters for the given options.
+func (options *ReviewListOptions) values() url.Values {
+	query := url.Values{}
+	set := func(param, value string) {
+		if value != "" {
+			query.Set(param, value)
+		}
+	}
+	setTime := func(param string, t time.Time) {
+		if !t.IsZero() {
+			query.Set(param, t.Format(time.RFC3339))
+		}
+	}
+	if options.Closed {
+		query.Set("status", "closed")
+	}
+	set("sort", options.Order)
+	if options.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(options.PageSize))
+	}
+	set("page", options.PageToken)
+	set("requester", options.Requester)
+	set("reviewer", options.Reviewer)
+	set("target", options.TargetRef)
+	set("state", options.State)
+	set("path", options.PathPrefix)
+	set("description", options.Description)
+	setTime("created_after", options.CreatedAfter)
+	setTime("created_before", options.CreatedBefore)
+	setTime("updated_after", options.UpdatedAfter)
+	setTime("updated_before", options.UpdatedBefore)
+	return query
+}
+
+// ListReviews lists a page of the reviews in the given repository.
+func (c *Client) ListReviews(ctx context.Context, repo string, options *ReviewListOptions) (*types.ReviewListResponse, error) {
+	if options == nil {
+		options = &ReviewListOptions{}
+	}
+	var page types.ReviewListResponse
+	if err := c.getJSON(ctx, repoPath(repo)+"/reviews", options.values(), &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// ListAllReviews lists every review in the given repository, following the pages of the list.
+func (c *Client) ListAllReviews(ctx context.Context, repo string, options *ReviewListOptions) ([]review.Summary, error) {
+	pageOptions := ReviewListOptions{}
+	if options != nil {
+		pageOptions = *options
+	}
+	pageOptions.PageToken = ""
+	var reviews []review.Summary
+	for {
+		page, err := c.ListReviews(ctx, repo, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, page.Items...)
+		if page.NextPageToken == "" {
+			return reviews, nil
+		}
+		pageOptions.PageToken = page.NextPageToken
+	}
+}
+
+// GetReview reads the review of the given revision in the given repository.
+func (c *Client) GetReview(ctx context.Context, repo, revision string) (*review.Review, error) {
+	r := &review.Review{Summary: &review.Summary{}}
+	if err := c.getJSON(ctx, reviewPath(repo, revision), nil, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// FindReview reads the review identified by the given reference, which is a commit in the review,
+// its branch, or its alias or Change-Id, as well as the review's own revision.
+func (c *Client) FindReview(ctx context.Context, repo, ref string) (*review.Review, error) {
+	r := &review.Review{Summary: &review.Summary{}}
+	// The server redirects the lookup to the review, which the HTTP client follows.
+	if err := c.getJSON(ctx, repoPath(repo)+"/lookup", url.Values{"ref": {ref}}, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetReviewDiff reads the diff of the given review between the given commits. Empty commits
+// default to the review's base and head commits.
+func (c *Client) GetReviewDiff(ctx context.Context, repo, revision, lhs, rhs string) (*types.DiffSummary, error) {
+	query := url.Values{}
+	if lhs != "" {
+		query.Set("lhs", lhs)
+	}
+	if rhs != "" {
+		query.Set("rhs", rhs)
+	}
+	var diff types.DiffSummary
+	if err := c.getJSON(ctx, reviewPath(repo, revision)+"/diff", query, &diff); err != nil {
+		return nil, err
+	}
+	return &diff, nil
+}
+
+// GetFile reads the contents of the file at the given path as of the given commit.
+func (c *Client) GetFile(ctx context.Context, repo, commit, path string) ([]byte, error) {
+	var escaped []string
+	for _, element := range strings.Split(path, "/") {
+		escaped = append(escaped, url.PathEscape(element))
+	}
+	return c.get(ctx, repoPath(repo)+"/commits/"+url.PathEscape(commit)+"/files/"+strings.Join(escaped, "/"), nil)
+}
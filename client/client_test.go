@@ -0,0 +1,218 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/git-appraise-web/api"
+)
+
+// runTestGit runs the given git command in the given directory, failing the test if it fails.
+func runTestGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v failed: %v: %s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// newTestServer serves a repository with three open reviews, requested by alice, bob, and alice,
+// in that order, and returns the server's URL along with the reviewed revisions.
+func newTestServer(t *testing.T) (string, []string) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "git-appraise-web-client-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	runTestGit(t, dir, "init", "-q")
+	runTestGit(t, dir, "symbolic-ref", "HEAD", "refs/heads/master")
+	if err := ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("# Test repo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runTestGit(t, dir, "add", "README.md")
+	runTestGit(t, dir, "commit", "-q", "-m", "Initial commit")
+	var revisions []string
+	for i, requester := range []string{"alice", "bob", "alice"} {
+		branch := fmt.Sprintf("change%d", i)
+		runTestGit(t, dir, "checkout", "-q", "-b", branch, "master")
+		runTestGit(t, dir, "commit", "-q", "--allow-empty", "-m", "Change "+branch)
+		runTestGit(t, dir, "notes", "--ref", "refs/notes/devtools/reviews", "add", "-m", fmt.Sprintf(
+			`{"timestamp":"%010d","reviewRef":"refs/heads/%s","targetRef":"refs/heads/master","requester":"%s","description":"Change %d"}`,
+			100*(i+1), branch, requester, i), "HEAD")
+		revisions = append(revisions, runTestGit(t, dir, "rev-parse", "HEAD"))
+		runTestGit(t, dir, "checkout", "-q", "master")
+	}
+
+	repo, err := api.NewGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := api.NewRepoCache()
+	cache.AddConfiguredRepo(repo, api.RepoConfig{Slug: "test", RepoMetadata: api.RepoMetadata{Name: "Test"}})
+	cache.Index()
+	mux := http.NewServeMux()
+	mux.Handle("/api/v2/", http.StripPrefix("/api/v2", cache.V2Handler()))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server.URL, revisions
+}
+
+func TestClient(t *testing.T) {
+	serverURL, revisions := newTestServer(t)
+	c := New(serverURL)
+	ctx := context.Background()
+
+	repos, err := c.ListRepos(ctx, "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 || repos[0].ID != "test" || repos[0].Name != "Test" {
+		t.Fatalf("Unexpected repositories: %+v", repos)
+	}
+	summary, err := c.GetRepo(ctx, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.OpenReviewCount != 3 || summary.ReadmeTitle != "Test repo" {
+		t.Fatalf("Unexpected summary: %+v", summary)
+	}
+	if _, err := c.GetScanProgress(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := c.ListReviews(ctx, "test", &ReviewListOptions{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Revision != revisions[2] || page.NextPageToken == "" {
+		t.Fatalf("Unexpected first page: %+v", page)
+	}
+	reviews, err := c.ListAllReviews(ctx, "test", &ReviewListOptions{PageSize: 1, Order: "requester", Requester: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reviews) != 2 || reviews[0].Revision != revisions[2] || reviews[1].Revision != revisions[0] {
+		t.Fatalf("Unexpected reviews by alice: %+v", reviews)
+	}
+	closed, err := c.ListAllReviews(ctx, "test", &ReviewListOptions{Closed: true})
+	if err != nil || len(closed) != 0 {
+		t.Fatalf("Unexpected closed reviews: %+v, %v", closed, err)
+	}
+
+	r, err := c.GetReview(ctx, "test", revisions[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Revision != revisions[1] || r.Request.Requester != "bob" {
+		t.Fatalf("Unexpected review: %+v", r.Summary)
+	}
+	found, err := c.FindReview(ctx, "test", "change1")
+	if err != nil || found.Revision != revisions[1] {
+		t.Fatalf("Unexpected review for the change1 branch: %+v, %v", found, err)
+	}
+	diff, err := c.GetReviewDiff(ctx, "test", revisions[1], "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RightHandSide != revisions[1] || len(diff.ReviewCommits) != 2 {
+		t.Fatalf("Unexpected diff: %+v", diff)
+	}
+	contents, err := c.GetFile(ctx, "test", revisions[1], "README.md")
+	if err != nil || string(contents) != "# Test repo" {
+		t.Fatalf("Unexpected file contents: %q, %v", contents, err)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	serverURL, revisions := newTestServer(t)
+	c := New(serverURL + "/")
+	ctx := context.Background()
+
+	_, err := c.GetRepo(ctx, "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("Unexpected error for a missing repository: %v", err)
+	}
+	_, err = c.GetReview(ctx, "test", strings.Repeat("0", len(revisions[0])))
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "Invalid review") {
+		t.Fatalf("Unexpected error for a missing review: %v", err)
+	}
+	_, err = c.GetFile(ctx, "test", revisions[0], "missing/file.txt")
+	if !IsNotFound(err) {
+		t.Fatalf("Unexpected error for a missing file: %v", err)
+	}
+	_, err = c.ListReviews(ctx, "test", &ReviewListOptions{State: "merged"})
+	if apiErr, ok := err.(*Error); !ok || apiErr.StatusCode != http.StatusBadRequest || IsNotFound(err) {
+		t.Fatalf("Unexpected error for an invalid state: %v", err)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	serverURL, _ := newTestServer(t)
+	body, err := New(serverURL).get(context.Background(), "/openapi.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var document struct {
+		OpenAPI    string                     `json:"openapi"`
+		Paths      map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(body, &document); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(document.OpenAPI, "3.") {
+		t.Fatalf("Unexpected OpenAPI version: %q", document.OpenAPI)
+	}
+	// Every path that the client requests is described.
+	for _, path := range []string{"/api/v2/repos", "/api/v2/repos/{repo}", "/api/v2/repos/{repo}/reviews",
+		"/api/v2/repos/{repo}/reviews/{review}", "/api/v2/repos/{repo}/reviews/{review}/diff",
+		"/api/v2/repos/{repo}/commits/{commit}/files/{path}", "/api/v2/repos/{repo}/lookup", "/api/v2/scan",
+		"/api/review_details"} {
+		if _, ok := document.Paths[path]; !ok {
+			t.Errorf("The path %q is not described", path)
+		}
+	}
+	// Every schema that is referred to is described.
+	for _, ref := range strings.Split(string(body), `"$ref": "#/components/schemas/`)[1:] {
+		name := ref[:strings.Index(ref, `"`)]
+		if _, ok := document.Components.Schemas[name]; !ok {
+			t.Errorf("The schema %q is not described", name)
+		}
+	}
+	for _, name := range []string{"ReviewListResponse", "DiffSummary", "Review", "Summary", "CommentThread", "ErrorResponse"} {
+		if _, ok := document.Components.Schemas[name]; !ok {
+			t.Errorf("The schema %q is not described", name)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/subtle"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	runtimepprof "runtime/pprof"
+
+	"github.com/google/git-appraise-web/api"
+)
+
+var adminAddr string
+var adminToken string
+
+func init() {
+	flag.StringVar(&adminAddr, "admin_addr", "", "Address (e.g. localhost:6060) on which to serve the debugging and admin endpoints. Disabled if empty.")
+	flag.StringVar(&adminToken, "admin_token", "", "Bearer token required by the admin endpoints. Required unless --admin_addr is a loopback address, and for adding or removing repositories.")
+}
+
+// Check that the admin listener is either bound to a loopback address or protected by a token.
+func checkAdminAddr(addr, token string) error {
+	if token != "" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("The admin address %q is not a loopback address, so --admin_token must be set", addr)
+}
+
+// Wrap the given handler so that it rejects requests that do not carry the admin token.
+func requireAdminToken(token string, h http.Handler) http.Handler {
+	if token == "" {
+		return h
+	}
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actual := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(actual, expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func serveGoroutines(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	runtimepprof.Lookup("goroutine").WriteTo(w, 2)
+}
+
+// Serve the debugging endpoints on the admin address, if one was configured.
+//
+// The endpoints that add and remove repositories are only served when the admin token is set,
+// so that changing what the server serves always requires authentication.
+func serveAdmin(cache *api.RepoCache, newRepo api.RepoFactory) {
+	if adminAddr == "" {
+		return
+	}
+	if err := checkAdminAddr(adminAddr, adminToken); err != nil {
+		log.Fatal(err.Error())
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/goroutines", serveGoroutines)
+	mux.HandleFunc("/debug/repos", cache.ServeDebugJSON)
+	if adminToken != "" {
+		admin := api.NewRepoAdmin(cache, newRepo, configPath)
+		mux.HandleFunc("/admin/repos", admin.ServeRepos)
+		mux.HandleFunc("/admin/reindex", admin.ServeReindex)
+	} else {
+		log.Printf("The admin endpoints for adding and removing repositories are disabled, as --admin_token is not set")
+	}
+	go func() {
+		log.Fatal(http.ListenAndServe(adminAddr, requireAdminToken(adminToken, mux)))
+	}()
+}
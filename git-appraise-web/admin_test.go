@@ -0,0 +1,76 @@
+/*
+Copyright 2016 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckAdminAddr(t *testing.T) {
+	for _, tc := range []struct {
+		addr    string
+		token   string
+		allowed bool
+	}{
+		{"localhost:6060", "", true},
+		{"127.0.0.1:6060", "", true},
+		{"[::1]:6060", "", true},
+		{":6060", "", false},
+		{"0.0.0.0:6060", "", false},
+		{"10.0.0.1:6060", "", false},
+		{"example.com:6060", "", false},
+		{"localhost", "", false},
+		{":6060", "secret", true},
+		{"10.0.0.1:6060", "secret", true},
+	} {
+		err := checkAdminAddr(tc.addr, tc.token)
+		if allowed := err == nil; allowed != tc.allowed {
+			t.Errorf("Unexpected result for %q with token %q: %v", tc.addr, tc.token, err)
+		}
+	}
+}
+
+func TestRequireAdminToken(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	for _, tc := range []struct {
+		token         string
+		authorization string
+		status        int
+	}{
+		{"", "", http.StatusOK},
+		{"secret", "", http.StatusUnauthorized},
+		{"secret", "Bearer wrong", http.StatusUnauthorized},
+		{"secret", "secret", http.StatusUnauthorized},
+		{"secret", "Bearer secret ", http.StatusUnauthorized},
+		{"secret", "Bearer secret", http.StatusOK},
+	} {
+		r := httptest.NewRequest("GET", "/admin/repos", nil)
+		if tc.authorization != "" {
+			r.Header.Set("Authorization", tc.authorization)
+		}
+		w := httptest.NewRecorder()
+		requireAdminToken(tc.token, ok).ServeHTTP(w, r)
+		if w.Code != tc.status {
+			t.Errorf("Unexpected status with token %q and authorization %q: %d", tc.token, tc.authorization, w.Code)
+		}
+		if tc.status == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") != "Bearer" {
+			t.Errorf("Missing WWW-Authenticate header for authorization %q", tc.authorization)
+		}
+	}
+}
@@ -22,8 +22,8 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/google/git-appraise-web/api"
 	"github.com/google/git-appraise-web/third_party/assets"
@@ -31,9 +31,43 @@ import (
 )
 
 var port int
+var basePath string
+var maxGitProcesses int
+var maxGitProcessesPerRepo int
+var rateLimit float64
+var rateLimitBurst int
+var trustForwardedFor bool
+var trustForwardedPrefix bool
+var requestTimeout time.Duration
+var watchRepos bool
+var pollInterval time.Duration
+var indexDir string
+var reviewCacheMB int64
+var commitCacheMB int64
+var diffCacheMB int64
+var scanWorkers int
+var rescanInterval time.Duration
+var configPath string
 
 func init() {
 	flag.IntVar(&port, "port", 8080, "Port on which to start the server.")
+	flag.StringVar(&basePath, "base_path", "", "URL path prefix under which the server is mounted (e.g. /reviews).")
+	flag.IntVar(&maxGitProcesses, "max_git_processes", 16, "Maximum number of concurrent git commands (0 for no limit).")
+	flag.IntVar(&maxGitProcessesPerRepo, "max_git_processes_per_repo", 4, "Maximum number of concurrent git commands per repository (0 for no limit).")
+	flag.Float64Var(&rateLimit, "rate_limit", 20, "Sustained number of API requests per second allowed for each client (0 for no limit).")
+	flag.IntVar(&rateLimitBurst, "rate_limit_burst", 100, "Number of API requests a client may make in a single burst.")
+	flag.DurationVar(&requestTimeout, "request_timeout", time.Minute, "Deadline for serving each API request (0 for no deadline).")
+	flag.BoolVar(&watchRepos, "watch_repos", true, "Refresh repositories in the background when their refs change.")
+	flag.DurationVar(&pollInterval, "poll_interval", 30*time.Second, "How often to refresh repositories that are not watched (0 to instead check for changes on every request).")
+	flag.StringVar(&indexDir, "index_dir", "", "Directory in which to store the review indexes, so that they survive restarts (disabled if empty).")
+	flag.Int64Var(&reviewCacheMB, "review_cache_mb", 64, "Approximate memory, in megabytes, used to cache review details.")
+	flag.Int64Var(&commitCacheMB, "commit_cache_mb", 16, "Approximate memory, in megabytes, used to cache commit details.")
+	flag.Int64Var(&diffCacheMB, "diff_cache_mb", 128, "Approximate memory, in megabytes, used to cache diffs.")
+	flag.StringVar(&configPath, "config", "", "JSON file listing the repositories to serve (defaults to every repository under the current directory).")
+	flag.IntVar(&scanWorkers, "scan_workers", 8, "Number of directories to scan for repositories in parallel.")
+	flag.DurationVar(&rescanInterval, "rescan_interval", time.Minute, "How often to scan for repositories that were added or removed (0 to only scan at startup).")
+	flag.BoolVar(&trustForwardedFor, "trust_forwarded_for", false, "Identify rate-limited clients by the X-Forwarded-For header.")
+	flag.BoolVar(&trustForwardedPrefix, "trust_forwarded_prefix", false, "Include the path prefix reported in the X-Forwarded-Prefix header in redirects and links.")
 }
 
 func serveStaticContent(w http.ResponseWriter, r *http.Request) {
@@ -58,55 +92,122 @@ func serveStaticContent(w http.ResponseWriter, r *http.Request) {
 	w.Write(contents)
 }
 
+// Construct the handler for the API endpoints, which are served under the "/api" path, and for the
+// links to reviews, which are served at "/review". Both are subject to the request timeout and rate limit.
+func apiHandler(cache *api.RepoCache) http.Handler {
+	apiMux := http.NewServeMux()
+	apiMux.HandleFunc("/repos", cache.ServeListReposJSON)
+	apiMux.HandleFunc("/scan_progress", cache.ServeScanProgressJSON)
+	apiMux.HandleFunc("/repo_summary", cache.ServeRepoSummaryJSON)
+	apiMux.HandleFunc("/repo_contents", cache.ServeRepoContents)
+	apiMux.HandleFunc("/closed_reviews", cache.ServeClosedReviewsJSON)
+	apiMux.HandleFunc("/open_reviews", cache.ServeOpenReviewsJSON)
+	apiMux.HandleFunc("/review_details", cache.ServeReviewDetailsJSON)
+	apiMux.HandleFunc("/review_diff", cache.ServeReviewDiff)
+	apiMux.Handle("/v2/", http.StripPrefix("/v2", cache.V2Handler()))
+	apiMux.Handle("/graphql", cache.GraphQLHandler())
+	mux := http.NewServeMux()
+	mux.Handle("/api/", http.StripPrefix("/api", apiMux))
+	// Links to a review by any commit in it, its branch, or its Change-Id.
+	mux.HandleFunc("/review", cache.ServeReviewRedirect)
+	handler := api.WithTimeout(requestTimeout, cache.RedirectLegacyIDs(mux))
+	if rateLimit <= 0 {
+		return handler
+	}
+	limiter := api.NewRateLimiter(rateLimit, rateLimitBurst)
+	limiter.TrustForwardedFor = trustForwardedFor
+	return limiter.Limit(handler)
+}
+
 // Serve our (fixed set of) URL paths
-func serveRepos(cache api.RepoCache) {
-	http.HandleFunc("/_ah/health",
-		func(w http.ResponseWriter, r *http.Request) {
-			fmt.Fprintf(w, "ok")
-		})
-	http.HandleFunc("/static/", serveStaticContent)
-	http.HandleFunc("/api/repos", cache.ServeListReposJSON)
-	http.HandleFunc("/api/repo_summary", cache.ServeRepoSummaryJSON)
-	http.HandleFunc("/api/repo_contents", cache.ServeRepoContents)
-	http.HandleFunc("/api/closed_reviews", cache.ServeClosedReviewsJSON)
-	http.HandleFunc("/api/open_reviews", cache.ServeOpenReviewsJSON)
-	http.HandleFunc("/api/review_details", cache.ServeReviewDetailsJSON)
-	http.HandleFunc("/api/review_diff", cache.ServeReviewDiff)
-	http.HandleFunc("/", cache.ServeEntryPointRedirect)
-	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", port), nil))
+func serveRepos(cache *api.RepoCache) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", cache.ServeHealthz)
+	mux.HandleFunc("/readyz", cache.ServeReadyz)
+	mux.HandleFunc("/static/", serveStaticContent)
+	limited := apiHandler(cache)
+	mux.Handle("/api/", limited)
+	mux.Handle("/review", limited)
+	mux.HandleFunc("/", cache.ServeEntryPointRedirect)
+	root := http.NewServeMux()
+	// App Engine sends its health checks to this path, whatever the base path is.
+	root.HandleFunc("/_ah/health", cache.ServeHealthz)
+	root.Handle("/", api.WithBasePath(basePath, trustForwardedPrefix, mux))
+	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", port), root))
+}
+
+// Construct the function that opens each local repository that is found.
+func localRepoFactory(limiter *api.GitLimiter, caches *api.Caches) api.RepoFactory {
+	return func(path string) (repository.Repo, error) {
+		gitRepo, err := api.NewGitRepo(path)
+		if err != nil {
+			return nil, err
+		}
+		return caches.Wrap(limiter.Wrap(gitRepo)), nil
+	}
 }
 
-// Find all local repositories under the current working directory.
-func getLocalRepos() (api.RepoCache, error) {
+// Load the configuration of the repositories to serve.
+//
+// Without a configuration file, every repository under the current working directory is served.
+func loadConfig() (*api.Config, error) {
+	if configPath != "" {
+		return api.LoadConfig(configPath)
+	}
 	cwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
 	}
-	repos := make(api.RepoCache)
-	filepath.Walk(cwd, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() {
-			gitRepo, err := repository.NewGitRepo(path)
-			if err == nil {
-				repos.AddRepo(gitRepo)
-				return filepath.SkipDir
-			}
-		}
-		return nil
-	})
-	return repos, nil
+	return &api.Config{Roots: []api.ScanRoot{{Path: cwd}}}, nil
 }
 
 func main() {
 	flag.Parse()
-	repos, err := getLocalRepos()
+	config, err := loadConfig()
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	if len(repos) == 0 {
-		log.Fatal("Unable to find any local repositories under the current directory")
+	repos := api.NewRepoCache()
+	limiter := api.NewGitLimiter(maxGitProcesses, maxGitProcessesPerRepo)
+	caches := api.NewCaches(reviewCacheMB<<20, commitCacheMB<<20, diffCacheMB<<20)
+	repos.SetCaches(caches)
+	if indexDir != "" {
+		store, err := api.NewIndexStore(indexDir)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		repos.SetIndexStore(store)
 	}
+	newRepo := localRepoFactory(limiter, caches)
+	for _, repoConfig := range config.Repos {
+		if repoConfig.Mirror != nil {
+			if err := api.InitMirror(repoConfig.Path); err != nil {
+				log.Printf("Unable to create the mirror %q: %v", repoConfig.Path, err)
+				continue
+			}
+		}
+		repo, err := newRepo(repoConfig.Path)
+		if err != nil {
+			log.Printf("Unable to open the repository %q: %v", repoConfig.Path, err)
+			continue
+		}
+		repos.AddConfiguredRepo(repo, repoConfig)
+	}
+	// Repositories are served as soon as they are found, so the scan runs alongside the server.
+	go func() {
+		repos.Scan(config.Roots, scanWorkers, newRepo)
+		if repos.Len() == 0 {
+			// The readiness check keeps failing until a repository is found.
+			log.Printf("Unable to find any repositories to serve")
+		}
+		go repos.Index()
+		repos.RefreshInBackground(watchRepos, pollInterval)
+		if rescanInterval > 0 && len(config.Roots) > 0 {
+			for range time.Tick(rescanInterval) {
+				repos.Scan(config.Roots, scanWorkers, newRepo)
+			}
+		}
+	}()
+	serveAdmin(repos, newRepo)
 	serveRepos(repos)
 }
@@ -4,6 +4,8 @@ go 1.14
 
 require (
 	github.com/google/git-appraise v0.0.0-20200404013623-45703e83847b
+	github.com/graph-gophers/graphql-go v1.3.0
 	github.com/jteeuwen/go-bindata v3.0.7+incompatible // indirect
+	github.com/opentracing/opentracing-go v1.2.0 // indirect
 	golang.org/x/tools v0.0.0-20200511182540-da4261a3d099
 )
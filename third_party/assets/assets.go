@@ -25,7 +25,7 @@ func bindata_read(data []byte, name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-var _assets_ci_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\xdf\x6f\xdb\x36\x10\x7e\xd7\x5f\x71\xd1\x06\xd8\xc5\x62\xb1\xcd\x8a\x3d\xb8\xb2\x07\x37\xc9\x56\x63\x5d\x52\xc4\xe9\x8a\x62\x18\x0a\x9a\x3a\x49\x87\x52\xa4\x46\x52\x71\x8d\xc0\xff\xfb\x40\x49\xb6\x65\x39\xee\xfa\xd4\x27\x9b\xbc\xbb\xef\xfb\x78\x3f\x28\xc6\x67\x57\xb7\x97\xf7\x1f\xdf\x5d\x43\xee\x0a\x39\x0d\xe2\xb3\xd1\x28\xb8\xd4\xe5\xda\x50\x96\x3b\xb8\x78\xfe\xe2\x17\xf8\x5d\xeb\x4c\x22\xcc\x95\x88\x60\x26\x25\xd4\x26\x0b\x06\x2d\x9a\x07\x4c\xa2\x20\x78\x4b\x02\x95\xc5\x04\x2a\x95\xa0\x01\x97\x23\xcc\x4a\x2e\x72\x84\xd6\x72\x0e\x7f\xa1\xb1\xa4\x15\x5c\x44\xcf\x61\xe8\x1d\xc2\xd6\x14\x3e\x7b\x15\xac\x75\x05\x05\x5f\x83\xd2\x0e\x2a\x8b\xe0\x72\xb2\x90\x92\x44\xc0\x2f\x02\x4b\x07\xa4\x40\xe8\xa2\x94\xc4\x95\x40\x58\x91\xcb\x6b\x92\x16\x22\x0a\x3e\xb6\x00\x7a\xe9\x38\x29\xe0\x20\x74\xb9\x06\x9d\x76\xbd\x80\xbb\x20\x00\x00\xc8\x9d\x2b\xc7\x8c\xad\x56\xab\x88\xd7\x2a\x23\x6d\x32\x26\x1b\x2f\xcb\xde\xce\x2f\xaf\x6f\x16\xd7\xa3\x8b\xe8\x79\x10\xbc\x57\x12\xad\x3f\xeb\xbf\x15\x19\x4c\x60\xb9\x06\x5e\x96\x92\x04\x5f\x4a\x04\xc9\x57\xa0\x0d\xf0\xcc\x20\x26\xe0\xb4\xd7\xb9\x32\xe4\x48\x65\xe7\x60\x75\xea\x56\xdc\x60\x90\x90\x75\x86\x96\x95\x3b\x48\xd0\x56\x15\x59\xe8\x3a\x68\x05\x5c\x41\x38\x5b\xc0\x7c\x11\xc2\xeb\xd9\x62\xbe\x38\x0f\x3e\xcc\xef\xdf\xdc\xbe\xbf\x87\x0f\xb3\xbb\xbb\xd9\xcd\xfd\xfc\x7a\x01\xb7\x77\x70\x79\x7b\x73\x35\xbf\x9f\xdf\xde\x2c\xe0\xf6\x37\x98\xdd\x7c\x84\x3f\xe6\x37\x57\xe7\x80\xe4\x72\x34\x80\x5f\x4a\xe3\xb5\x6b\x03\xe4\x53\xe7\x2b\xb5\x40\x3c\x20\x4f\x75\x23\xc6\x96\x28\x28\x25\x01\x92\xab\xac\xe2\x19\x42\xa6\x1f\xd0\x28\x52\x19\x94\x68\x0a\xb2\xbe\x78\x16\xb8\x4a\x02\x49\x05\x39\xee\xea\xf5\xd1\x71\xa2\x60\x34\x9a\x06\x71\xd3\x4c\x00\x71\x8e\x3c\x99\xd6\x59\x8f\x25\xa9\xcf\x60\x50\x4e\x42\x2a\x4a\x6d\x5c\x08\xb9\xc1\x74\x12\xfa\x72\xd8\x31\x63\x86\xaf\xa2\x8c\x5c\x5e\x2d\x2b\x8b\x46\x68\xe5\x50\xb9\x48\xe8\x82\x5d\xe9\x95\x92\x9a\x27\xac\xd4\x72\x5d\xa0\x19\x89\x44\xb1\x17\xd1\x45\xf4\x73\x74\xc1\x24\x2d\xb7\xfb\xdb\xdf\xc8\xd3\x87\xdf\x81\x96\x97\x7e\x97\x9b\xa4\xf3\xf7\xfb\x92\x3b\xad\xe5\x92\x9b\xc3\xd5\x56\xc2\xd7\x35\x30\xeb\xab\x28\x98\xa3\x02\xad\xe3\x45\x79\x2a\xcc\xba\xb5\x44\x9b\x23\x1e\xc9\x4f\xb5\x72\x36\xca\xea\xfb\x81\x97\x64\x6b\xe5\x24\xb4\xfa\x35\xe5\x05\xc9\xf5\xe4\x4f\xee\xd0\x10\x97\x3f\xcd\x85\x56\xb6\xce\x4a\xcc\xb6\x3d\x11\x2f\x75\xb2\x6e\x13\x95\xe8\x62\x54\xe8\xa4\x92\x08\x94\x4c\x42\x9f\x09\x52\x95\xae\xec\x88\x94\xc3\xcc\xd4\xfd\xd6\x66\x15\x20\x76\x58\x94\x92\x3b\xdc\x6e\x00\xc4\xb5\xca\xfd\x1a\x20\xb2\x95\x10\x7e\x00\x1e\x3b\x9b\x00\x4b\x2e\x3e\x67\x46\x57\x2a\x19\x09\x2d\xb5\x19\xff\xc0\x5f\xa6\x29\x7f\xf9\xaa\xe3\xb5\x09\xba\x38\x29\x27\x59\x19\xfc\x5f\x1c\x8f\xd2\xc7\xd9\xc9\x63\x3d\x7d\xf1\xbe\x63\xba\xa2\xe3\x83\x42\x4e\x0f\x18\x63\x02\x21\xb9\xb5\x93\xb0\x68\xd3\x3a\xa2\x3a\xad\xa0\xd5\xc8\xf1\x72\x12\x3a\x9d\x65\x12\xdf\x50\x92\xa0\x0a\xa7\x8f\x8f\xcd\xda\xe7\x7e\xb3\x89\x19\xf5\xe0\x6c\xc9\xd5\x16\xd1\x91\x93\x18\x4e\x2f\x77\x79\x87\xf9\x3e\xef\xe3\x98\x79\xdf\x03\x9d\xec\xa4\xd0\xd8\xd5\x77\x63\x5e\xab\xf8\x71\x12\x3e\x3e\x36\x7f\x37\x9b\xb0\x27\x60\x5b\x45\x20\x3b\x09\x7d\x07\x18\x2c\x91\xbb\x10\xc8\x61\x61\x7d\xa4\x41\xdf\xb1\xf6\x28\xd4\x07\x9b\x46\x7b\xcd\xe0\x03\x22\xdf\xcf\x95\xf7\xed\x72\xd7\x96\x13\x02\x1a\x9c\x64\x1a\xa7\x86\x50\x25\x72\x3d\xda\xcd\x02\xec\xfe\xed\x40\x76\x3b\x1e\x27\x66\xc7\x31\xd3\x98\xb9\xe4\x69\x86\x16\x82\x67\xa8\x9c\x2f\xc5\x29\xbf\xa3\x4d\x80\x98\xb7\x43\xd7\x62\x54\x46\x9e\x38\x22\xbe\xaf\x4d\xd3\x5e\x3a\x62\xc6\x9f\xc4\xad\xcb\xdf\x87\xa9\x4e\x40\xf4\xeb\xbf\xed\x82\x63\xcd\x31\x73\xfd\xbe\x65\xc7\xe3\x5a\xef\xfa\x3e\xe9\x4c\x04\x3b\x1e\x89\xe3\xd0\xd8\x0a\x43\xa5\xdb\x87\xbd\x6b\xee\xc7\x61\x77\x3a\xc9\x8e\x61\xf0\xf4\x1d\x32\x38\xef\xf8\x95\x46\x97\x68\x1c\xa1\x1d\xf7\xa6\xbb\x6d\xbc\xfe\x36\x80\x5b\x97\x38\x86\x99\x31\x7c\x7d\xde\x33\xe9\x65\xfd\x20\x32\x63\x18\x7c\x12\x5c\x69\x45\x82\xcb\xbb\x06\x68\x70\xe0\xbb\x39\x0c\xdd\x8f\xe9\x29\xbe\x85\x33\xfe\x4d\xd1\xb3\x3d\x70\x59\xe1\x18\x42\x52\x09\x3a\xff\x95\x56\xdc\xe1\x27\x91\xa3\xf8\xfc\x69\xa9\xbf\x84\x5f\xe3\x6c\x0a\x7f\x8a\xef\xb5\xd6\x12\xb9\x3a\x41\x98\x72\x69\xf1\x10\x3c\x38\x41\x74\x94\x88\x31\xa4\x95\x12\xbe\x12\xc3\x67\x3d\x72\xff\x18\x19\x3e\x70\x03\xc6\xbf\xa3\xfc\x1b\x30\x6a\xcb\xd0\xf7\xf4\x4a\x4c\x5b\x23\x98\x1c\xb8\xfe\x6d\xfe\x79\xd5\xf3\x6d\x2c\xed\x25\x00\x13\x38\x1b\x0e\x9a\xc6\x1e\x78\x9e\xc6\xfa\xac\x1f\x44\x29\x0c\xcf\x86\x83\xca\xc8\xae\xd7\xb1\x90\x2e\xbc\x1f\x40\x2f\xc7\x54\xd8\x87\xdb\x7c\x5b\xba\xba\x17\xf8\x57\x32\x55\x1f\x78\x7f\x9e\xce\xf2\x90\xd7\x1f\xa2\x63\x3c\x56\x5f\x1b\xf7\xed\x07\x13\x08\x79\x92\x3c\xd1\x3c\x80\xd2\xf6\xbf\x80\x4f\x86\x7f\x5b\x2f\x3e\xf9\x8d\xdc\xec\x8a\x10\xb3\xee\x94\xc7\x6c\xff\x3a\x68\x5e\x10\xcd\xc3\x21\x66\xcd\x33\xf3\xbf\x00\x00\x00\xff\xff\x85\x51\xe4\xda\xc4\x0c\x00\x00")
+var _assets_ci_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x56\xdb\x6e\xe3\x36\x10\x7d\xd7\x57\x4c\xd4\x02\x71\x50\x4b\xda\x4d\x17\x7d\x48\x64\x17\xde\x24\xed\x1a\xdd\x3a\x8b\x38\xe9\x22\x28\x8a\x80\x96\x28\x99\x58\x8a\x54\x49\x2a\x8e\x11\xf8\xdf\x3b\x94\x64\x5b\x97\x38\xcd\xd3\xfa\xc5\xe6\xcc\xf0\xcc\xe1\xdc\x3c\xe1\xd1\xe5\xf5\xc5\xed\xfd\x97\x2b\x58\x9a\x8c\x8f\x9d\xf0\xc8\xf3\x9c\x0b\x99\xaf\x15\x4b\x97\x06\x4e\xdf\xbd\xff\x05\x7e\x97\x32\xe5\x14\xa6\x22\xf2\x61\xc2\x39\x94\x2a\x0d\x8a\x6a\xaa\x1e\x69\xec\x3b\xce\x67\x16\x51\xa1\x69\x0c\x85\x88\xa9\x02\xb3\xa4\x30\xc9\x49\x84\x5f\xb5\x66\x08\x7f\x51\xa5\x99\x14\x70\xea\xbf\x83\x81\x35\x70\x6b\x95\x7b\x72\xee\xac\x65\x01\x19\x59\x83\x90\x06\x0a\x4d\x11\x80\x69\x48\x18\x3a\xa5\x4f\x11\xcd\x0d\x30\x01\x91\xcc\x72\xce\x88\x88\x28\xac\x98\x59\x96\x4e\x6a\x08\xdf\xb9\xaf\x01\xe4\xc2\x10\xb4\x25\x68\x9d\xe3\x29\x69\x5a\x01\x31\x8e\x03\xf8\x59\x1a\x93\x9f\x05\xc1\x6a\xb5\xf2\x49\xc9\xd2\x97\x2a\x0d\x78\x65\xa5\x83\xcf\xd3\x8b\xab\xd9\xfc\xca\x43\xa6\x8e\x73\x27\x38\xd5\xf6\xad\xff\x16\x4c\xe1\x03\x17\x6b\x20\x39\xf2\x88\xc8\x02\xd9\x71\xb2\x02\xa9\x80\xa4\x8a\xa2\xce\x48\xcb\x73\xa5\x98\x61\x22\x1d\x82\x96\x89\x59\x11\x45\x9d\x98\x69\xa3\xd8\xa2\x30\xad\x00\x6d\x59\xe1\x4b\x9b\x06\x18\x22\x22\xc0\x9d\xcc\x61\x3a\x77\xe1\xe3\x64\x3e\x9d\x0f\x9d\xaf\xd3\xdb\x4f\xd7\x77\xb7\xf0\x75\x72\x73\x33\x99\xdd\x4e\xaf\xe6\x70\x7d\x03\x17\xd7\xb3\xcb\xe9\xed\xf4\x7a\x86\xa7\xdf\x60\x32\xbb\x87\x3f\xa6\xb3\xcb\x21\x50\x0c\x0f\x3a\xa1\x4f\xb9\xb2\xdc\x91\x20\xb3\xa1\xb3\x99\x9a\x53\xda\x72\x9e\xc8\x8a\x8c\xce\x69\xc4\x12\x16\xe1\x8b\x44\x5a\x90\x94\x42\x2a\x1f\xa9\x12\xf8\x10\xc8\xa9\xca\x98\xb6\xc9\xd3\x48\x2d\x76\x38\xcb\x98\x21\xa6\x3c\xf7\x9e\xe3\x3b\x9e\x87\x55\x54\x15\x13\x40\xb8\xa4\x24\x1e\x97\x51\x0f\x39\x13\xdf\x30\x90\x7c\xe4\x22\x1d\xa9\x8c\x0b\x4b\x45\x93\x91\x6b\xd3\xa1\x31\x1f\x8a\xac\xfc\x14\xa9\x17\x0b\x2c\x01\x15\x49\x61\xa8\x30\x3e\xa6\x3d\xb8\x94\x2b\xc1\x25\x89\x83\x5c\xf2\x75\x46\x95\x17\xc5\x22\x78\xef\x9f\xfa\x3f\xfb\xa7\x98\xb7\xc5\x56\xbe\xfd\xf6\xad\x7b\xf7\x3b\xb8\x25\xb9\x95\x12\x15\x37\x7e\x7e\x5f\xe7\x46\x4a\xbe\x20\xaa\x7d\xda\x52\x78\x9d\x83\x61\x19\xd5\x86\x64\xf9\x21\x73\x6d\xd6\x58\xfc\x4b\x4a\x7b\xb4\x13\x24\xaa\xfd\xb4\x9c\x0b\x24\x67\xba\x64\xcc\x90\xfe\xaf\x09\xc9\x18\x5f\x8f\xfe\x24\x86\x2a\x46\xf8\x4f\x53\x14\xea\x32\x1a\x61\xb0\xad\x85\x70\x21\xe3\x75\x1d\xa0\x58\x66\x5e\x26\xe3\x02\x9b\x89\xc5\x23\xd7\x46\x80\x89\x42\x16\xda\x63\x18\x8b\x54\x95\x75\x56\x47\x13\xcd\x0d\xc5\x4a\x46\xec\xad\x00\x45\x25\xcb\xfd\x19\xc0\xd7\x45\x14\xd9\xc2\x7f\x6e\x08\x01\x16\x24\xfa\x96\x2a\x89\x15\xeb\x45\x92\x4b\x75\xf6\x03\xf9\x90\x24\xe4\xc3\x79\xc3\x6a\xe3\x34\x71\x12\xc2\x78\xa1\xe8\xff\xe2\x58\x94\x2e\xce\x8e\x5e\xd0\xe1\x17\xee\x2b\xa5\x49\x3a\x6c\x25\x70\xdc\xf2\x18\x32\x88\x38\xd1\x7a\xe4\x66\x75\x58\x3d\x56\x86\x15\x87\x85\x67\x48\x8e\xa9\x94\x29\x66\xe2\x13\x8b\x63\x8a\xc1\x7a\x7e\xae\xce\x36\xf6\x9b\x4d\x18\xb0\x0e\x9c\xce\x71\xc2\xd4\x88\x86\x19\x4e\xdd\xf1\xc5\x2e\xee\x38\xe6\x77\x71\x3f\x43\xf6\x68\xdb\xe2\x19\x1c\x24\x1a\x9a\x72\x26\x2e\x4b\x16\x3f\x8e\xdc\xe7\xe7\xea\xe7\x66\xe3\x76\x08\x6c\xb3\x88\xb3\x6f\xe4\xda\x0a\x50\x34\xa7\x04\xab\x8c\xa1\x46\xdb\x9b\x28\xc0\x4a\xd5\xbd\xab\xf6\xb2\xaa\xb8\x97\x1e\xec\x05\x1f\x8b\xd8\x14\xd6\xb6\xe9\xbb\xd4\x1c\x20\x50\xe1\xc4\xe3\x30\x51\x8c\x8a\x98\xaf\xbd\x5d\x2f\xc0\xee\xd7\x0e\x64\x27\xb1\x38\x61\xd0\xbf\x83\x42\x13\xbf\xec\xa1\x86\xc0\x81\x2a\x8c\x4d\xc5\x21\xbb\x9e\x10\xc5\xa4\x6e\xba\x1a\xa3\x50\xfc\xc0\x13\xe9\x5d\xa9\x1a\x77\xc2\x11\x06\xe4\x45\xdc\x32\xfd\x5d\x98\xe2\x00\x44\x37\xff\xdb\x2a\xe8\x73\x46\x59\xb7\x6e\x83\x7e\xbb\x96\x52\x5b\x27\x8d\x8e\x08\xfa\x2d\xd1\xbf\x1a\xea\x48\xb1\xdc\xec\xaf\x7d\xa9\xe6\xe2\xa0\xd9\x9d\x4c\x9f\xc1\xf1\xcb\x33\xe4\x78\xd8\xb0\xcb\x95\x44\x8f\x86\x51\xb4\x6f\x77\x77\x5d\x78\x5d\x31\x80\x59\xe7\xf4\x0c\x26\x4a\x91\xf5\xb0\xa3\x92\x8b\x72\x11\x52\xe8\xfb\x21\x22\x42\x0a\xdc\x0e\xf8\x4d\x05\x74\xdc\xb2\xdd\xb4\xaf\xee\xdb\xf4\x90\xbf\x39\xee\x05\xb8\x4b\x74\x74\x8f\x84\x17\xa8\x74\x19\xfe\xfb\x1a\xfb\xef\x2c\x30\x50\x0f\xb8\xc8\x44\xdf\x1e\x16\xf2\xc9\x7d\xcd\x67\x95\xf8\x43\xfe\x3e\x62\x5b\x53\x22\x0e\x38\x4c\x08\xd7\xb4\x0d\xee\x1c\x70\xd4\x0b\x04\xde\x2e\x44\x64\x33\x31\x38\xe9\x38\xb7\x4b\xc8\xe0\x91\x28\x50\x76\x7f\xb2\xbb\x9f\x5f\xa7\xe1\xa4\x47\xb3\x34\x2b\x95\x30\x6a\x99\xfe\xad\xfe\x39\xef\xd8\x56\x9a\x7a\x08\xa0\xf9\xd1\xe0\xb8\x2a\xec\x63\xeb\xa7\xd2\x9e\x74\x2f\xb1\x04\x06\x68\x88\xfd\xd0\xb4\xea\x13\x69\xc2\xdb\x06\xb4\x74\x54\x41\xbb\x70\x9b\xb7\x85\xab\x39\xc0\x5f\x89\x54\xf9\xe0\xfd\x7b\x1a\xc7\xb6\x5f\xfb\x88\x86\xb2\xcf\xbe\x54\xee\xcb\x0f\xc1\x5c\x12\xc7\x2f\x14\x0f\x50\x4c\xf9\x5b\xae\xbf\xad\x16\x5f\xfc\x8f\xdc\xec\x92\x80\xf3\xa6\xd1\xe5\x61\xb0\xdf\x0e\xaa\x0d\xa2\x5a\x1c\x70\x93\x28\xd7\xcb\xff\x00\x85\xca\xd4\x85\xbc\x0c\x00\x00")
 
 func assets_ci_html() ([]byte, error) {
 	return bindata_read(
@@ -34,7 +34,7 @@ func assets_ci_html() ([]byte, error) {
 	)
 }
 
-var _assets_comments_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x5f\x73\xe3\xb6\x11\x7f\xd7\xa7\xd8\x63\x3b\x91\x3c\x35\xc9\x8b\x7b\xd3\x07\x1d\xe5\xc6\xf1\x5d\x1b\x4d\x53\x3b\x73\x72\x9a\xb9\xa7\x1b\x88\x58\x92\xa8\x41\x80\x05\x40\xcb\xac\xa3\xef\xde\x01\x29\x4a\xfc\x2b\x29\xd7\xdc\x8d\x1f\x44\x10\xbf\xfd\xbf\xd8\x5d\xc2\xc1\xab\x77\xf7\xb7\x0f\x1f\x7f\x7a\x0f\x89\x49\xf9\xf5\x24\x78\xe5\xba\x93\x5b\x99\x15\x8a\xc5\x89\x81\xab\xd7\xdf\xfe\x05\xfe\x2e\x65\xcc\x11\x96\x22\xf4\xe0\x86\x73\x28\xb7\x34\x28\xd4\xa8\x9e\x90\x7a\x93\xc9\x8f\x2c\x44\xa1\x91\x42\x2e\x28\x2a\x30\x09\xc2\x4d\x46\xc2\x04\x61\xb7\x73\x09\xff\x42\xa5\x99\x14\x70\xe5\xbd\x86\x99\x05\x38\xbb\x2d\xe7\xe2\xed\xa4\x90\x39\xa4\xa4\x00\x21\x0d\xe4\x1a\xc1\x24\x4c\x43\xc4\x38\x02\x3e\x87\x98\x19\x60\x02\x42\x99\x66\x9c\x11\x11\x22\x6c\x98\x49\x4a\x21\x3b\x16\xde\xe4\xe3\x8e\x81\x5c\x1b\xc2\x04\x10\x08\x65\x56\x80\x8c\x9a\x28\x20\x66\x32\x01\x00\x48\x8c\xc9\xe6\xbe\xbf\xd9\x6c\x3c\x52\x6a\xe9\x49\x15\xfb\xbc\x42\x69\xff\xc7\xe5\xed\xfb\xbb\xd5\x7b\xf7\xca\x7b\x3d\x99\xfc\x2c\x38\x6a\x6b\xeb\x7f\x72\xa6\x90\xc2\xba\x00\x92\x65\x9c\x85\x64\xcd\x11\x38\xd9\x80\x54\x40\x62\x85\x48\xc1\x48\xab\xe7\x46\x31\xc3\x44\x7c\x09\x5a\x46\x66\x43\x14\x4e\x28\xd3\x46\xb1\x75\x6e\x5a\x0e\xaa\xb5\x62\x1a\x9a\x00\x29\x80\x08\x70\x6e\x56\xb0\x5c\x39\xf0\xfd\xcd\x6a\xb9\xba\x9c\xfc\xb2\x7c\xf8\xe1\xfe\xe7\x07\xf8\xe5\xe6\xc3\x87\x9b\xbb\x87\xe5\xfb\x15\xdc\x7f\x80\xdb\xfb\xbb\x77\xcb\x87\xe5\xfd\xdd\x0a\xee\xff\x06\x37\x77\x1f\xe1\x1f\xcb\xbb\x77\x97\x80\xcc\x24\xa8\x00\x9f\x33\x65\x75\x97\x0a\x98\x75\x9d\x8d\xd4\x0a\xb1\x25\x3c\x92\x95\x32\x3a\xc3\x90\x45\x2c\x04\x4e\x44\x9c\x93\x18\x21\x96\x4f\xa8\x04\x13\x31\x64\xa8\x52\xa6\x6d\xf0\x34\x10\x41\x27\x9c\xa5\xcc\x10\x53\xae\x7b\xe6\x78\x13\xd7\xbd\x9e\x04\x55\x32\x01\x04\x09\x12\x7a\x5d\x7a\x3d\xe0\x4c\x3c\x82\x42\xbe\x70\x58\x9a\x49\x65\x1c\x48\x14\x46\x0b\xc7\x86\x43\xcf\x7d\x5f\x91\x8d\x17\x33\x93\xe4\xeb\x5c\xa3\x0a\xa5\x30\x28\x8c\x17\xca\xd4\x7f\x27\x37\x82\x4b\x42\xfd\x4c\xf2\x22\x45\xe5\x86\x54\xf8\xdf\x7a\x57\xde\x9f\xbd\x2b\x9f\xb3\x75\xfd\xbe\xfe\xf5\xac\x78\xe7\x2b\x88\x25\x99\x7d\x4b\x14\x6d\x3c\x7e\x5d\xe1\xcc\x60\xda\x78\xfc\xba\xc2\x39\xd3\x66\x2d\x9f\xdb\xab\xaf\xab\x82\x91\x92\xaf\x89\x6a\xaf\x6a\x15\x8e\xeb\xe0\x6b\x9b\xc5\xa1\x9f\x12\xf5\x48\xe5\x46\x9c\xa5\x78\x4d\x64\x58\x8a\xda\x90\x34\x1b\x93\xa5\x4d\xc1\x51\x27\x88\x3d\x9b\x23\x29\x8c\xf6\xe2\xb2\xa8\x92\x8c\xe9\xd2\x5c\x16\x4a\xf1\xd7\x88\xa4\x8c\x17\x8b\x7f\x12\x83\x8a\x11\xfe\xa7\x65\x28\x85\x2e\x35\x0a\xfc\xfa\x20\x05\x6b\x49\x8b\x9d\x92\x54\xa6\x6e\x2a\x69\xce\x11\x18\x5d\x38\xa1\x4c\x53\x14\xc6\x35\x89\x42\x42\x77\x96\x00\x04\x06\xd3\x8c\x13\x83\xf5\x0b\x80\xa0\xd4\xee\xb0\x06\x68\xf9\x0f\x5e\x1a\x3b\x00\xdf\xd9\x8a\x57\xcc\x5c\x97\x93\x42\xe6\xc6\x4d\xa4\x62\xff\x95\xc2\x10\x7e\xf1\xb6\x05\x0c\x25\x97\x6a\x0e\x6b\x4e\xc2\xc7\xe6\xce\x76\xd2\x58\x78\x51\xc1\x3a\x02\xd6\x24\x7c\x8c\x95\xcc\x05\x75\x2b\x16\x7f\xc0\x37\xf6\x6f\x9c\x07\x8f\x4d\x7a\x06\x93\x28\x3a\xc6\x44\xa4\x9b\x93\x3c\x2c\x87\x63\x3c\x22\x8e\xcf\x61\xc2\x38\x3d\xe1\x33\x8b\xbb\x18\x67\x43\x72\x93\xc8\xae\xdf\x37\x8c\x9a\x64\x0e\x4c\x24\xa8\x98\x69\xbb\x3a\x23\x94\x32\x11\x0f\xee\xb6\x59\x6b\xc1\xb2\x0c\x8d\x6b\x13\x08\x7f\x8b\x88\x01\x67\xbc\xb1\x7f\x6d\x94\xcd\x66\xb7\x4a\xdc\x39\xa4\x52\x48\x9d\x91\x10\x3b\x9c\xa4\xa2\xa8\xdc\xb5\x34\x46\xa6\x73\xe0\x76\x7e\x88\x15\x16\x47\x50\x6e\x99\xa1\x73\xd0\x92\x33\x7a\x0c\xb7\xb3\xc0\x24\x4c\x9c\xf6\xc1\xae\xc0\xe8\x6e\xd8\x4b\x8e\xa7\x14\x3b\xa9\xd1\x6f\x52\x85\x33\x81\x9f\x7b\xce\x8e\x45\xcd\xf2\x75\x13\xb4\x96\xcc\x41\x48\x95\x12\x7e\xe4\x18\x59\xb0\xc8\xd3\xf5\x58\x62\xbc\x79\x9d\x3d\x7f\xe1\xac\x50\x95\xaa\xc7\x7d\x5f\x82\x4e\x47\xa0\x82\x9d\x17\x07\x99\xab\x10\x43\x49\xbb\x51\x38\x47\xf5\x4d\xc2\x0c\xba\xe5\xc6\x1c\x32\x85\xee\x46\x91\xac\x03\x91\x8a\xba\x6b\x85\xe4\x71\x0e\xe5\x8f\x4b\x38\x3f\x37\x8c\xdb\x43\xa5\xf6\x3b\xa5\x3a\x38\x34\xfb\x66\xfd\x0e\x0e\x03\xc8\x75\x4b\x48\xd0\xae\xec\x21\x27\x5a\xff\x71\xe1\xbc\xbc\xd8\x46\x96\xeb\xed\xd6\x69\xe3\x01\x02\x56\xa1\x16\x4e\xba\x6b\x44\x2e\x2b\x1b\x11\x48\xe1\x1a\x92\x2d\x1c\x23\xe3\x98\xe3\x0f\x8c\x52\x14\xce\xf5\xcb\x4b\xb5\xb6\xdd\x6a\xbb\x0d\x7c\xd6\x63\x18\x29\x86\x82\xf2\xc2\xdd\x77\x4d\xd8\x3f\x59\x55\xaa\x86\xe5\xed\xfa\x97\xb7\xdf\xb3\xca\x05\x7e\x9f\xba\x27\x80\xb2\xa7\x5a\xe7\x43\x41\xae\x6a\x6a\xa9\x5f\x9b\x7f\xb5\x61\x55\xa5\xec\x69\x88\xd7\xf5\xc1\x3b\x03\x98\xa0\x3d\x6c\x74\x36\xad\x2a\x49\xe9\x99\xd2\xcb\x09\xa3\xb8\xaa\x8e\xfc\x76\xeb\xd4\x4a\x76\xeb\x51\x3f\x06\x0d\x8b\xda\x05\xbc\x61\xcf\x6e\xc3\xcb\x88\x49\xb6\xdb\xef\x7a\xef\x6b\xc7\xdd\xca\x34\x65\x66\xcc\xde\x7a\x3a\x00\xa6\x17\x8e\x9d\x28\x14\x66\x48\x8c\x03\x36\xc5\x74\x23\x3c\x35\x5b\x5b\x34\x86\xf2\x66\x58\x6b\x8b\x1e\x80\xb6\xc1\x8d\x3a\x34\x88\xdd\x87\xa5\x9c\x71\x2d\xfa\xae\x04\x8f\xd8\x54\x45\x69\x6c\x63\x30\x59\x0e\xe5\xc0\xa9\xc5\xd4\xc1\x19\x15\x32\xec\x4f\xbf\x3f\x6e\x8d\x80\x07\x72\x85\xa2\x18\x3a\x91\xf5\x88\xea\x46\x0c\x39\x05\x83\xcf\x66\xe0\xdc\x50\xd4\xa1\x62\x99\xfd\x32\xab\x4e\x4e\x9b\xac\xc7\xb5\x3d\x2f\xea\x5e\xc0\x4b\xcf\x28\xdc\x31\xeb\xa0\x4f\x58\x17\xf8\x43\x05\x29\xf0\xfb\xd5\xab\xef\xb0\xa0\x32\xe3\x40\x16\xe5\x22\xb4\x56\x41\x8c\xe6\xb6\x52\xe3\xa1\xd4\x62\x55\x9e\xd2\x59\xd8\x7c\x77\xd1\xaa\xe7\x2c\x82\xd9\x54\xa1\x96\xfc\x09\xe9\x74\x77\x8f\x30\x06\xae\xe0\x2d\x80\x57\xd3\x76\x91\x00\x0a\x4d\xae\x04\x4c\xed\x2c\x3a\x6d\x57\xf6\x2d\x20\xd7\xdd\xc6\x72\xa0\x10\xe9\xa6\x4b\x30\x39\x41\x5c\x93\x46\x05\x9b\x0e\x77\x8a\x46\x7b\xfb\xa9\xfa\x64\x9a\xb5\x1c\xa1\xe7\x30\x6d\xc7\x70\x7a\xd9\xfc\x00\x50\x32\x43\x65\x18\xea\x79\x47\x74\x05\xee\xbe\x05\x30\x45\x86\x73\xb8\x5f\xff\x1b\x43\x73\xd9\xd9\x93\xeb\xf2\x6e\x48\xcd\x61\xfa\x89\x32\x9d\x71\x52\x54\xfe\xd4\xd3\xb6\xdd\x6d\xc2\x43\x23\x19\x13\xb7\x32\x8a\x89\xb8\x2b\xee\x89\xf0\x1c\xe7\xe0\x30\x41\xd1\xa0\x4a\x99\x20\x06\x3f\x85\x09\x86\x8f\x9f\xd6\xf2\xd9\x39\x26\xb3\x3a\x75\x63\xf2\xbe\x97\x92\x23\x11\x23\x02\x23\xc2\x35\x1e\x63\xce\x84\x2d\x56\x5f\x88\x79\xa3\xb7\x7c\x9e\x04\xa3\xf2\xa3\x02\xaa\x26\xf8\x59\xa1\x28\x13\x75\x3c\xc5\x9b\x54\x9f\x12\xa2\xf7\x66\xd4\x47\x7d\x36\x74\x32\x4d\xc2\xb4\x57\xb9\xf4\x02\x5e\xea\x33\x51\xfa\xe9\x6d\x8b\xff\xfe\xbc\xcc\xa6\xbb\x3e\x54\x1e\xfd\x92\xbe\x4a\xe7\xf6\xb7\x58\x4b\x9d\x76\xbe\x9e\xa3\x52\xc5\x12\xbe\xf9\xa6\xd7\x22\x66\x33\xeb\xab\xf2\x1e\xf2\x20\x1a\x16\x0b\x98\xca\xf2\xdc\x4c\x2f\x86\xa8\x1a\xe0\xba\xb8\x9f\xcb\xbc\xc6\xb7\x85\xf4\x8b\x57\x49\x52\xc5\x17\x16\x63\x75\x75\xcc\x5f\x7b\x06\x8d\x0c\x84\xc5\xfe\x15\x45\x01\xbf\xfe\x0a\xaf\xca\x75\x23\xba\xb3\x1e\x13\xeb\xc1\x57\xb3\x69\xa3\x71\x75\xe3\xb4\xb7\xa7\x6f\xc2\xa0\x9f\x9a\x4d\x10\x16\x30\x9d\x76\x45\x6e\xcf\x4b\xca\xe6\x88\x7b\x24\x05\x9a\x26\x2f\x76\x16\x57\xcb\xb7\x83\xb8\xff\xc3\x5b\xfb\x6c\xab\x68\x46\x42\x7a\xa8\xa0\xb0\x00\x87\x50\x3a\x50\xff\x86\x9b\xd3\x00\xf9\x79\xe5\x74\xb8\x1b\xed\xb5\x0f\xfc\x66\x3b\x0f\xfc\xc3\xa5\x55\x7d\x69\x76\xf4\x1a\x4b\x1f\xbd\xc7\x6a\xdd\x3c\xb6\xe6\x8f\xd3\x63\x6d\xf9\xd0\x9b\xb6\x3a\x53\xd1\xae\xfb\xd5\xf8\xa1\x51\xa8\x3d\xf6\x0c\x28\xe9\x0f\x6a\x79\xc6\xdc\x73\x66\x1f\xd7\x67\x36\xf2\xd2\xe0\xb1\x6a\x7e\xa3\x14\x29\x7e\xb7\xc0\xda\x75\x75\x51\x19\xf8\xd5\xff\x02\xfe\x17\x00\x00\xff\xff\xea\xc3\xaf\x17\x69\x1a\x00\x00")
+var _assets_comments_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x58\x4b\x73\xdb\x36\x10\xbe\xf3\x57\xc0\x6c\xa7\x92\xa7\x26\x99\xb8\x99\x1e\x14\xc9\x8d\x62\xbb\x8d\xa6\xa9\x9d\x89\x9c\x66\x72\xf2\x40\x24\x28\xa1\x06\x09\x16\x80\x2c\xab\x8e\xfe\x7b\x17\xa0\x28\x11\x7c\x48\x4a\x26\xb1\x7d\x10\x41\xec\xe3\xdb\x07\x76\x97\xe8\x1f\x5d\x5c\x9f\xdf\x7c\x7a\x77\x89\x66\x2a\x61\x67\x4e\xff\xc8\xf3\x9c\x73\x9e\x2d\x05\x9d\xce\x14\x3a\x7d\xf6\xfc\x57\xf4\x07\xe7\x53\x46\xd0\x28\x0d\x7d\x34\x64\x0c\x99\x2d\x89\x04\x91\x44\xdc\x93\xc8\x77\x9c\xb7\x34\x24\xa9\x24\x11\x9a\xa7\x11\x11\x48\xcd\x08\x1a\x66\x38\x84\x9f\xf5\xce\x09\xfa\x9b\x08\x49\x79\x8a\x4e\xfd\x67\xa8\xab\x09\xdc\xf5\x96\x7b\xfc\xd2\x59\xf2\x39\x4a\xf0\x12\xa5\x5c\xa1\xb9\x24\x20\x80\x4a\x14\x53\x50\x4a\x1e\x42\x92\x29\x44\x53\x14\xf2\x24\x63\x14\xa7\x21\x41\x0b\xaa\x66\x46\xc9\x5a\x84\xef\x7c\x5a\x0b\xe0\x13\x85\x81\x16\x03\x75\x06\xab\xb8\x4c\x85\xb0\x72\x1c\x04\x7f\x33\xa5\xb2\x5e\x10\x2c\x16\x0b\x1f\x1b\x94\x3e\x17\xd3\x80\xe5\x54\x32\x78\x3b\x3a\xbf\xbc\x1a\x5f\x7a\x80\xd4\x71\x3e\xa4\x8c\x48\x6d\xeb\xbf\x73\x2a\xc0\xc0\xc9\x12\xe1\x0c\x70\x84\x78\x02\xe8\x18\x5e\x20\x2e\x10\x9e\x0a\x02\x7b\x8a\x6b\x9c\x0b\x41\x15\x4d\xa7\x27\x48\xf2\x58\x2d\xb0\x20\x4e\x44\xa5\x12\x74\x32\x57\x96\x83\x0a\x54\x60\x69\x99\x00\x5c\x84\x53\xe4\x0e\xc7\x68\x34\x76\xd1\xeb\xe1\x78\x34\x3e\x71\x3e\x8e\x6e\xde\x5c\x7f\xb8\x41\x1f\x87\xef\xdf\x0f\xaf\x6e\x46\x97\x63\x74\xfd\x1e\x9d\x5f\x5f\x5d\x8c\x6e\x46\xd7\x57\xb0\xfa\x1d\x0d\xaf\x3e\xa1\x3f\x47\x57\x17\x27\x88\x80\x7b\x40\x09\x79\xc8\x84\xc6\x0e\x00\xa9\x76\x9d\x8e\xd4\x98\x10\x4b\x79\xcc\x73\x30\x32\x23\x21\x8d\x69\x08\x16\xa5\xd3\x39\x9e\x12\x34\xe5\xf7\x44\xa4\x60\x08\xca\x88\x48\xa8\xd4\xc1\x93\x00\x2d\x72\x18\x4d\xa8\xc2\xca\xac\x6b\xe6\xf8\x8e\xe7\x41\x16\xe5\xc9\x84\x50\x7f\x46\x70\x74\x66\xbc\xde\x67\x34\xbd\x03\x47\xb2\x81\x0b\x70\xb8\x50\x2e\x9a\x09\x12\x0f\x5c\x1d\x0e\x09\xf1\x10\x78\xe1\x4f\x01\xfa\x7c\x02\x29\x20\x42\x9e\x2a\x92\x2a\x1f\xc2\x1e\x5c\xf0\x45\xca\x38\x8e\x82\x8c\xb3\x65\x42\x84\x17\x46\x69\xf0\xdc\x3f\xf5\x7f\xf1\x4f\x21\x6e\x93\xe2\x7d\xf1\xeb\x6b\xf5\xee\x13\xa8\xc5\x99\x7e\x8b\x45\x54\x7a\x7c\x5a\xe5\x54\x91\xa4\xf4\xf8\xb4\xca\x19\xe4\xed\x84\x3f\xd8\xab\xa7\x85\xa0\x38\x67\x13\x2c\xec\x55\x01\x61\x37\x86\x04\x8b\xbb\x08\xb4\x1c\x04\x58\xd1\x84\x48\x85\x93\xac\x4d\xb6\x54\x4b\xa8\x14\x33\x42\x6a\x36\xc6\x60\x95\xf4\xa7\xa6\x88\xe2\x8c\x4a\x63\x1e\x05\x5b\x7f\x8b\x71\x42\xd9\x72\xf0\x17\x56\x44\x50\xcc\x7e\x1e\xc1\x4b\x69\x90\xf4\x83\xe2\xe0\xf4\x27\x3c\x5a\xae\xc1\x45\x3c\xf1\x12\x1e\xcd\xa1\xf2\xd0\x68\xe0\x82\x9c\x04\xdc\xe5\x29\xd0\x87\xa3\xb5\x05\x40\x06\x79\x90\x31\x90\x59\xbc\x80\x57\x06\xdd\x76\x8d\x90\xe5\x2f\xf4\x58\xda\x41\xe8\x95\xae\x70\xcb\xae\xe7\x31\x0c\x85\x59\x79\x33\x2e\xe8\x7f\x60\x04\x66\x50\xaa\xcb\x84\x21\x67\x5c\xf4\xd0\x84\xe1\xf0\xae\xbc\xb3\x72\x4a\x0b\x3f\x5e\xd2\x8a\x82\x09\xd0\x4f\x05\x87\xe2\xe1\xe5\x22\x7e\x20\x2f\xf4\x7f\xbb\x0c\x36\x55\xc9\x01\x42\xe2\x78\x97\x90\x34\x59\xec\x95\xa1\x25\xec\x92\x11\x33\xe8\x48\x33\xca\xa2\x3d\x3e\xd3\x74\xc7\xed\x62\xf0\x5c\x81\x57\x2b\x32\x16\x34\x52\xb3\x1e\xf4\x10\xa8\xde\x54\xd9\xae\xce\x70\x14\x41\x31\x6e\xdc\xb5\x45\xcb\x94\x66\x19\x81\xa8\x41\x4e\x90\x2f\x51\xd1\xe0\x8c\x17\xfa\xdf\xa6\xd2\xd9\xec\xe5\x89\xdb\x43\x09\x4f\xb9\x84\x16\x4a\x2a\x92\xb8\x00\xd5\xde\x84\x2b\xc5\x93\x1e\x62\x7a\x5e\x80\x16\xb9\xdc\x41\xe5\x99\x0c\xed\x41\xd3\x64\x34\xda\x45\xb7\xb6\x00\x26\x84\x74\xbf\x0f\xd6\x05\x45\x56\xc3\x6e\x24\xee\x03\xb6\x17\xd1\x17\x41\x81\x4a\x41\xbe\xf6\x9c\xed\x8a\x9a\x96\x0b\xb1\xd6\x96\xf4\x60\x7e\x12\x09\x66\x3b\x8e\x91\x26\x4e\xe7\xc9\xa4\x2d\x31\x5e\x3c\xcb\x1e\xbe\x73\x56\x88\x1c\xea\x6e\xdf\x1b\xa2\xfd\x11\xc8\xc9\x0e\x8b\x03\x9f\x8b\x90\x84\x3c\xaa\x46\xe1\x10\xe8\x8b\x19\x34\x57\xcf\x6c\xf4\x10\x4c\x55\xde\x42\xe0\xac\x42\x02\x88\xbc\x09\x14\xe2\x3b\x28\x88\xfa\xc7\xc3\x8c\x1d\x1a\xc6\xd5\xb6\x52\x07\x95\x52\xdd\xdf\x36\xf7\x72\xfd\xee\x6f\x07\x8e\x33\x4b\x49\xdf\xae\xec\x21\xc3\x52\xfe\x38\x70\x1f\x1f\xa1\x7b\xa9\xb9\x5c\xad\x5c\x9b\x1e\x38\x68\x4e\xa5\x3b\x62\xde\x88\x3c\x6a\x1a\x11\xcc\xa2\x9e\xc2\x19\x34\x3f\x3e\x85\xde\xf5\x86\x46\x11\x49\xdd\xb3\xc7\xc7\x7c\xad\xbb\xd5\x6a\xd5\x0f\x68\x4d\x60\x2c\x28\x49\x23\xb6\xf4\x36\x5d\x13\x6d\x9e\x34\x94\xbc\x61\xf9\xeb\xfe\xe5\x6f\xf6\x34\xb8\x7e\x50\xe7\xae\x29\x88\xe8\x7d\x81\x79\x5b\x90\xf3\x9a\x6a\xf0\xd9\xf2\xf3\x0d\x0d\x15\xf8\x9a\x64\x9d\x6d\xbd\xd3\x40\xd3\xb7\x87\x8b\xca\xa6\x86\x32\x33\x9e\x31\x5e\x86\x47\x32\xce\x8f\x3c\x58\x53\x80\xac\xd6\x23\x77\x97\x45\x76\x01\x2f\xd9\xb3\xde\xf0\x33\xac\x66\xab\xd5\xab\xda\xfb\xc2\x71\xe7\x60\x37\x55\x6d\xf6\x16\xd3\x01\x7c\x78\x0c\x5c\x3d\x51\x08\x92\x11\x0c\x53\x8b\x4e\x31\x59\x0a\x4f\x21\x56\x17\x8d\xa6\xbc\x69\x46\xad\xa9\x1b\x48\x6d\xe2\x52\x1d\x6a\xa4\xdd\x84\xc5\xcc\xb4\x9a\xfa\xca\x10\xb7\xd8\x94\x47\xa9\x6d\xa3\x31\x59\xb6\xe5\xc0\x2d\xd4\x14\xc1\x69\x55\xd2\xec\xcf\xa0\x3e\x6e\xb5\x10\x37\xe4\x0a\x3c\x36\x9d\xc8\x62\x34\xf5\x62\x4a\x00\xac\x22\x0f\xaa\xe1\xdc\x44\x44\x86\x82\x66\xfa\x4b\x2c\x3f\x39\x36\x5b\x4d\xaa\x3d\x2f\xca\x5a\xc0\x8d\x67\x04\x59\x0b\xab\x50\xef\xb1\xae\x1f\x34\x15\xa4\x7e\x50\xaf\x5e\x75\x87\xf5\x73\x33\xb6\x6c\xf1\x3c\x0d\xb5\x55\x68\x4a\xd4\x79\x0e\xe3\xc6\xa0\x18\x9b\x53\xda\x0d\xcb\xef\x8e\xad\x7a\x4e\x63\xd4\xed\xc0\x67\x2f\x67\xf7\x24\xea\xac\xef\x0d\xda\x88\x73\x72\x8b\xc0\x2f\x78\xab\x94\x08\xc6\x7c\x35\x17\x29\xea\xe8\x59\xb4\x63\x57\xf6\x15\x22\x4c\x92\x56\x0e\x18\x3c\xab\x0c\xce\x1e\xe6\x82\x15\x86\xe7\x4e\x73\xa7\x28\xb5\xb7\x77\xf9\x27\x52\xd7\x72\x84\xec\xa1\x8e\x1d\xc3\xce\x49\xf9\x03\x40\x70\x88\x8c\xa2\x04\xe8\x6c\xd5\x39\x71\xaf\x66\x8d\x5a\x66\xd0\xfd\xae\x27\xff\x90\x50\x9d\x54\xf6\xf8\xc4\xdc\x05\xc1\x4c\xd5\xb9\x8d\xa8\x84\xf0\x2e\x73\x7f\xca\x8e\x6d\xb7\xcd\xb8\x6d\x24\x6d\xea\xc6\x4a\xe8\xcb\x94\xca\xde\x3d\x66\x73\xd8\x74\x69\x1a\x11\xa5\xaf\x27\x52\x48\xa7\xdb\x70\x46\xc2\xbb\x5b\xf8\x04\x75\x77\xe9\xcc\x4f\x5d\x9b\xbe\xd7\x50\xe4\x09\x4e\x5b\x14\xc6\x18\x22\xb5\x4b\x38\x4d\x75\xb1\xfa\x4e\xc2\x4b\xbd\xe5\xeb\x34\x28\x31\xdf\xa9\x20\x6f\x82\x5f\x15\x0a\x93\xa8\xed\x29\x5e\xe6\xba\x9d\x61\xb9\x31\xa3\x38\xea\xdd\xa6\x93\xa9\xaf\x01\xfd\xdc\xa5\xb0\x5d\x9c\x09\xe3\xa7\x97\x96\xfc\xcd\x79\xe9\x76\xd6\x7d\xc8\x1c\x7d\xc3\x9f\xa7\xb3\xfd\x2d\x66\xc1\xb1\xf3\xf5\x10\x48\xb9\x48\xf4\xd3\x4f\xb5\x16\xd1\xed\x6a\x5f\x99\x7b\xc7\xad\x6a\x34\x18\xa0\x0e\x37\xe7\xa6\x73\xdc\xc4\x55\x22\x2e\x8a\xfb\xa1\xc2\x0b\x7a\x5b\x49\xbd\x78\x19\x96\x3c\xbe\x68\xd0\x56\x57\xdb\xfc\xb5\x11\x50\xca\x40\x90\x52\xbc\x82\xe3\x84\x3e\x7f\x46\x47\x66\x5d\x8a\x6e\xb7\x26\x44\x7b\xf0\xa8\xdb\x29\x35\xae\x6a\x9c\x36\xf6\xd4\x4d\x68\xf4\x53\xb9\x09\x02\xa4\x4e\xa7\xaa\x72\x75\x58\x52\x96\x47\xdc\x1d\x29\x50\x36\x79\xb0\xb6\x38\x5f\xbe\x74\xbe\xb1\xb7\x36\xd9\x96\xf3\xb4\x84\x74\x5b\x41\x41\x85\x8b\xa3\xa8\xa1\xfe\x35\x37\xa7\x06\xf6\xc3\xca\x69\x73\x37\xda\xa0\x87\x2f\x98\x52\x3b\x87\x41\x61\x73\x69\x55\x5c\x9a\xed\xbc\xc6\x92\x3b\xef\xb1\xac\x9b\x46\x6b\xfe\xd8\x3f\xd6\x9a\x87\xda\xb4\x55\x99\x8a\xd6\xdd\xaf\xa0\x6f\x1a\x85\xec\xb1\xa7\x01\x64\xd0\x88\xf2\x80\xb9\xe7\xc0\x3e\x2e\x0f\x6c\xe4\xc6\xe0\xb6\x6a\x3e\x14\x02\x2f\xbf\x59\x60\xf5\x3a\xbf\xa8\xec\x07\xf9\xdd\xff\xff\xb1\xfb\x92\x03\x59\x1a\x00\x00")
 
 func assets_comments_html() ([]byte, error) {
 	return bindata_read(
@@ -43,7 +43,7 @@ func assets_comments_html() ([]byte, error) {
 	)
 }
 
-var _assets_commits_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\xff\x6f\xdb\xb8\x15\xff\xdd\x7f\xc5\xab\x36\xdc\xa5\x58\x2c\xe5\xb2\xe1\x7e\x70\xe4\x1c\xd2\x24\x5b\x8d\x75\xc9\x21\xce\xed\x50\x14\x45\x41\x8b\xcf\xd6\x5b\x29\x52\x23\xa9\xb8\x5e\xe0\xff\x7d\x20\x25\xcb\xfa\x66\xb7\xe8\x76\xc5\xa5\x40\x2d\x92\xef\xcb\xe7\x7d\xde\xe3\x23\xa5\xf8\xc5\xcd\xfd\xf5\xe3\xdb\x9f\x6f\x21\xb5\x99\xb8\x1c\xc5\x2f\xc6\xe3\xd1\xb5\xca\x37\x9a\x56\xa9\x85\xf3\xb3\x1f\x7e\x84\xbf\x29\xb5\x12\x08\x33\x99\x84\x70\x25\x04\xf8\x25\x03\x1a\x0d\xea\x27\xe4\xe1\x68\xf4\x86\x12\x94\x06\x39\x14\x92\xa3\x06\x9b\x22\x5c\xe5\x2c\x49\x11\xaa\x95\x53\xf8\x27\x6a\x43\x4a\xc2\x79\x78\x06\x27\x4e\x20\xa8\x96\x82\x97\x17\xa3\x8d\x2a\x20\x63\x1b\x90\xca\x42\x61\x10\x6c\x4a\x06\x96\x24\x10\xf0\x53\x82\xb9\x05\x92\x90\xa8\x2c\x17\xc4\x64\x82\xb0\x26\x9b\x7a\x27\x95\x89\x70\xf4\xb6\x32\xa0\x16\x96\x91\x04\x06\x89\xca\x37\xa0\x96\x4d\x29\x60\x76\x34\x02\x00\x48\xad\xcd\x27\x51\xb4\x5e\xaf\x43\xe6\x51\x86\x4a\xaf\x22\x51\x4a\x99\xe8\xcd\xec\xfa\xf6\x6e\x7e\x3b\x3e\x0f\xcf\x46\xa3\x5f\xa4\x40\xe3\x62\xfd\x77\x41\x1a\x39\x2c\x36\xc0\xf2\x5c\x50\xc2\x16\x02\x41\xb0\x35\x28\x0d\x6c\xa5\x11\x39\x58\xe5\x70\xae\x35\x59\x92\xab\x53\x30\x6a\x69\xd7\x4c\xe3\x88\x93\xb1\x9a\x16\x85\x6d\x11\xb4\x43\x45\x06\x9a\x02\x4a\x02\x93\x10\x5c\xcd\x61\x36\x0f\xe0\xd5\xd5\x7c\x36\x3f\x1d\xfd\x3a\x7b\x7c\x7d\xff\xcb\x23\xfc\x7a\xf5\xf0\x70\x75\xf7\x38\xbb\x9d\xc3\xfd\x03\x5c\xdf\xdf\xdd\xcc\x1e\x67\xf7\x77\x73\xb8\xff\x2b\x5c\xdd\xbd\x85\xbf\xcf\xee\x6e\x4e\x01\xc9\xa6\xa8\x01\x3f\xe5\xda\x61\x57\x1a\xc8\x51\xe7\x32\x35\x47\x6c\x39\x5f\xaa\x12\x8c\xc9\x31\xa1\x25\x25\x20\x98\x5c\x15\x6c\x85\xb0\x52\x4f\xa8\x25\xc9\x15\xe4\xa8\x33\x32\x2e\x79\x06\x98\xe4\x23\x41\x19\x59\x66\xfd\xb8\x17\x4e\x38\x1a\x8f\x2f\x47\x71\x59\x4c\x00\x71\x8a\x8c\x5f\x7a\xd6\x63\x41\xf2\x23\x68\x14\xd3\x80\xb2\x5c\x69\x1b\x40\xaa\x71\x39\x0d\x5c\x3a\xcc\x24\x8a\x34\x5b\x87\x2b\xb2\x69\xb1\x28\x0c\xea\x44\x49\x8b\xd2\x86\x89\xca\xa2\x1b\xb5\x96\x42\x31\x1e\xe5\x4a\x6c\x32\xd4\xe3\x84\xcb\xe8\x87\xf0\x3c\xfc\x73\x78\x1e\x09\x5a\xec\xe6\x77\xbf\xa1\x73\x1f\x7c\x03\xb7\x2c\x47\x3d\x5e\x14\xd6\x2a\xd9\x1a\x7c\x5b\x00\x56\x29\xb1\x60\xba\x3d\xfa\xb6\x10\x34\xe3\xa4\xda\x4c\x34\xa7\x76\x60\x8e\xa3\x89\x8c\x2b\xab\x24\xe2\xb4\x5c\x1e\xd2\x30\x76\x23\xd0\xa4\x88\xbd\x18\x96\x4a\x5a\x13\xae\x7c\xaf\x62\x39\x19\x0f\x9f\x12\x25\x7f\x5a\xb2\x8c\xc4\x66\xfa\x0f\x66\x51\x13\x13\x7f\x9a\x25\x4a\x1a\x4f\x4d\x1c\xed\xea\x33\x5e\x28\xbe\xa9\xd8\xe2\x2a\x1b\x67\x8a\x17\x02\x81\xf8\x34\x48\x54\x96\x91\x35\x63\x41\xc6\x56\x84\x02\xc4\x16\xb3\x5c\x30\x8b\xbb\x09\x80\xd8\x63\xdb\x8f\x01\x42\x91\x9a\xb1\x92\x62\x03\xcf\x8d\x59\x80\x05\x4b\x3e\xae\xb4\x2a\x24\x1f\x27\x4a\x28\x3d\xf9\x03\xfe\xc5\xfd\xbb\x68\x48\x6d\x9b\x76\x3c\x97\x1d\x23\x6b\xe2\x36\x9d\xc0\xf9\x59\xfe\xe9\xa0\x5e\x09\xdd\x23\xef\x42\x50\x9a\xbb\x72\x55\xd6\xaa\x6c\x02\xc2\xb5\xf4\x95\xc6\xcd\xc5\x61\xa9\xb1\x8f\x6f\x02\x46\x09\xe2\xc7\xe4\x2a\x64\x36\x25\x79\x08\x59\x73\xb3\x74\x90\xb9\x3c\x8e\x0d\xfd\x07\x27\x40\x32\x45\x4d\xb6\xed\x2b\x45\x87\x75\x70\x71\xef\x21\x8e\x3a\xc9\x88\x5b\x9b\xa3\x99\xa4\x98\x20\x11\xcc\x98\x69\x90\x55\x05\x32\x26\x5f\x20\xa0\xe4\xd8\xb2\x7c\x1a\x58\xb5\x5a\x09\x7c\x4d\x9c\xa3\x0c\x2e\x9f\x9f\xcb\xb1\xab\xa2\xed\x36\x8e\xa8\x65\xcc\xe4\x4c\xee\xec\x59\xb2\x02\x83\xcb\xeb\x94\xc9\x15\x9a\x49\x1c\xb9\xc5\x96\x74\x8b\x06\xcd\xc8\xf8\x03\xa0\xe9\x75\x26\x05\x49\x74\x5e\xc9\x3f\xbd\xf2\xb2\x8f\xce\xb2\x73\xde\x34\xd0\x08\x36\x3a\x10\x6d\x6c\xfd\xb1\x55\xe1\x6b\x54\x47\x00\xa9\x0f\xef\x8f\xd3\xe0\xf9\xb9\x7c\xdc\x6e\x83\x16\xd6\x5d\xc1\x03\x99\x69\xe0\xb6\x88\xc6\x1c\x99\x0d\x80\x2c\x66\xc6\xe9\x55\x1b\xa5\xa3\xe8\x54\x75\xe9\xd2\x5b\x77\xe2\xa1\xcf\x4e\x4f\xd0\x89\xf2\xcb\x4a\x44\xb2\xcc\x87\x68\xf9\x90\x50\xbc\xd4\x84\x92\x8b\xcd\xd8\x52\x86\xc6\xb2\x2c\x87\xfa\xa9\x76\xc3\xd1\x32\x12\x26\x74\x2b\xce\x5b\x1c\xf5\xd5\x2e\x0f\xb8\xd8\xb1\xc4\x0a\x9b\x2a\x1d\x5c\x76\x4c\x96\xd3\xb7\x19\x23\x71\x10\xe5\xce\x84\x29\xb2\x8c\xe9\x4d\xcf\x46\x35\xff\x59\x7d\xbf\xfd\x7b\x5c\xd5\xf5\xd3\xec\xb4\xe0\x68\x9b\x06\x22\x35\x41\x4f\x7c\xe8\x2f\x49\x31\xf9\x88\xbc\x66\x8c\xcc\x9b\xd4\x65\xf0\x8b\x94\x1b\x35\xe3\x95\x53\xe2\xf8\x06\x97\xf6\x35\x93\x7c\x8e\x02\x13\xfb\xa5\x96\x38\x19\x57\x99\x7c\x1a\x58\x5d\xe0\x50\xa8\x03\xa7\x4a\x8f\xb1\xff\x37\x8b\xfa\xeb\x59\x7c\xf8\xdf\x58\x7c\x70\x3d\xee\x77\x43\x63\x1c\xd9\x76\xcb\x8c\x06\x4e\xbf\xc8\xf7\x96\xc6\x44\x21\x69\x49\xc8\xc7\xee\x38\x87\xaa\xd4\x5d\x90\x6e\xbc\xdd\x06\x50\x36\x34\x1f\xb6\x7f\x72\x73\x83\x5d\x28\x8e\x9a\xa6\xea\x13\xb8\x07\x22\x36\x89\xa6\xdc\xee\x21\x2c\x0b\x99\xb8\x7b\x2a\xec\x76\xfd\x75\xd9\xa1\x4e\x34\x3e\x11\xae\x4f\x41\xa4\xe6\x14\xb4\xfb\xaf\xea\x5d\x2f\x5b\x87\x91\x4e\x4d\xa5\x01\x53\x78\xde\x8e\x1a\x4b\xb5\x69\xc6\xf9\xa3\x7a\x48\xcd\x49\x69\xe1\x65\xe7\x34\x2b\x67\xcb\x7e\x07\x53\x08\x16\xca\xa6\xc1\xc5\xa0\x48\xaa\xd6\x0f\xa9\xf3\xe4\x72\x37\x28\x32\x50\x19\x30\x85\x25\x13\xa6\x23\xbf\x07\xfe\xae\x52\x25\xfe\x1e\xa6\x95\x9d\xf6\x91\xd9\x0c\x4a\x69\x38\x79\x62\x1a\xa8\x7a\xe1\xea\x53\x02\xe0\xd6\xcb\xa5\xda\xa0\x79\x47\xef\x07\x01\xbb\x6d\x54\x4b\x85\xc4\x43\x53\x2c\xdc\xab\x8e\x5c\x9d\x9c\x9d\xfe\xf8\x72\x98\x87\x1d\x55\xbb\xcb\xd3\x30\x5d\xfd\x5e\x33\x4c\xc5\x51\xea\x0e\x32\xed\xdb\x20\x4c\xe1\xa4\x86\x0e\xd3\xa9\x2b\x97\x61\xcc\x7e\xbf\xf7\xc4\x75\x4f\x9c\x96\x5d\x11\x5f\x88\x5d\x8a\xa1\x57\x55\x6d\x33\x5b\x40\x61\xb0\xa7\x54\xa7\x2f\xdf\xa7\xaf\x3e\x6b\x72\xa6\x51\xf6\xb3\xb9\xcb\x68\xb9\xbc\xcf\x55\x47\xed\x5d\xde\x49\xf0\x2e\x9a\x4a\x8f\x64\xa3\xe6\x86\x7c\x7c\x2e\x24\xe8\x5c\x10\xfb\xe3\xed\x81\xab\x64\x55\x82\xa1\xc6\x27\xd4\x06\x4f\x5a\x86\x77\xf5\x79\xf6\x7e\x5f\x57\x7a\xa8\xae\x1a\x82\x83\x95\xd5\xad\x14\x8d\xb6\xd0\xf5\x1e\x19\xed\x81\xd5\x8f\x3f\x97\x2f\x4c\x27\x4d\x2e\xc8\x4c\xe0\xfb\xe6\xfb\xc4\xf7\xa7\xcd\x4b\xb1\x56\x39\x6a\x4b\x68\x26\x1d\x06\xcb\x32\xe9\xce\x02\xd8\x4d\x8e\x13\x98\xfb\x1d\xd5\x26\xeb\xb4\x35\x74\x7d\xf3\x90\xf6\xfd\xe2\x5f\x98\xd8\xd3\xce\x9a\x5a\xf8\x8f\x3b\x7a\x02\xc1\x87\x22\xe7\xcc\xe2\x0d\x2d\x97\xc1\x31\x27\x62\xc7\x19\x71\x3c\x0e\xb5\xeb\xec\x89\x89\x02\x27\x10\x1c\x35\xaf\xeb\xdd\xfb\xdb\xd8\xaf\xd2\x72\xc8\xf2\x95\xd6\x6c\x73\xc0\xf0\xbb\xf7\xc7\x0c\xef\x5f\x17\xbe\x0e\x35\x49\x8e\x16\x75\x46\x92\x59\xfc\xe0\xaf\x1a\x1f\x16\xea\xd3\xd1\x60\xca\xc3\xf3\x90\xbf\x57\x4a\x09\x64\xf2\x80\x43\xdf\x44\x8f\x19\x2f\x8f\xeb\xdf\xd4\x78\xe3\x35\xe7\xeb\x48\xbb\x21\x93\x0b\xb6\x81\xea\xfd\xe9\x70\x27\x69\x1a\x68\xbe\xe8\x4d\xea\x23\xfe\xa4\xdb\xcf\x6c\x4a\x26\x2c\x19\x86\x29\xbc\x68\x0c\xfb\xdd\xbe\xb1\xd8\x6f\x8b\x7e\x71\x5f\x1e\xae\x39\x31\xce\x07\x92\x3b\xdc\xef\x07\xd4\xbf\xac\x56\x8e\xc7\x3f\xab\xd2\x7b\x3c\xfe\x32\x4f\x75\xfc\xe5\xf0\xe2\x90\x5c\x23\x9f\xae\x99\xee\x17\x7e\xda\xa7\xca\xed\xeb\xf1\x62\x33\x76\xbf\x01\xf4\x73\x78\x71\x08\x76\xa3\x41\x1d\x41\x5d\x67\xc3\xdf\x47\xbf\xfb\x0e\xea\x41\x58\xb6\xd7\x83\xa7\x97\x17\x6c\x76\xb7\x5d\x08\x5e\xb9\xb9\xd0\x3d\xd3\xbc\x54\xab\x71\xb5\x54\x5b\x2b\x83\xba\x49\x7d\xfd\xec\x5e\x61\x4b\xd3\xd5\x3d\xb6\x87\xb0\xbb\x2d\x3e\xf3\xd7\xc7\xf9\x35\x06\x06\xb8\xbc\xf8\x82\x23\x7c\x5b\x4b\xc5\x51\xf3\x06\x1f\x47\xfb\x0f\x71\xe5\xc7\xba\xf2\x1b\x5d\x1c\x95\x5f\x97\x47\xff\x0d\x00\x00\xff\xff\x39\xe7\x92\x9a\xbc\x18\x00\x00")
+var _assets_commits_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x58\x5b\x6f\xdb\x36\x14\x7e\xd7\xaf\x38\xd5\x86\xd5\xc1\x2c\xa9\xed\x86\x3e\xd8\x72\x8a\x34\xc9\x56\x63\x5d\x52\xc4\xe9\x8a\xa2\x28\x0a\x5a\xa2\x6c\xae\x92\xa8\x91\x74\x1c\x2f\xf0\x7f\xdf\x21\x25\xcb\xba\xba\x41\xb6\x16\x4b\x81\xda\xe4\xb9\x7d\xe7\x4a\xd2\xfe\xa3\xb3\xcb\xd3\xeb\xf7\x6f\xce\x61\xa9\x92\xf8\xd8\xf2\x1f\x39\x8e\x75\xca\xb3\x8d\x60\x8b\xa5\x82\x67\x4f\x9e\x3e\x87\x5f\x39\x5f\xc4\x14\xa6\x69\xe0\xc2\x49\x1c\x83\x21\x49\x10\x54\x52\x71\x43\x43\xd7\xb2\x5e\xb3\x80\xa6\x92\x86\xb0\x4a\x43\x2a\x40\x2d\x29\x9c\x64\x24\xc0\x8f\x82\x32\x84\x3f\xa8\x90\x8c\xa7\xf0\xcc\x7d\x02\x03\xcd\x60\x17\x24\xfb\x68\x6c\x6d\xf8\x0a\x12\xb2\x81\x94\x2b\x58\x49\x8a\x0a\x98\x84\x88\xa1\x51\x7a\x1b\xd0\x4c\x01\x4b\x21\xe0\x49\x16\x33\x92\x06\x14\xd6\x4c\x2d\x8d\x91\x42\x85\x6b\xbd\x2f\x14\xf0\xb9\x22\xc8\x4b\x90\x3b\xc3\x55\x54\xe5\x02\xa2\x2c\x0b\xf0\x6f\xa9\x54\x36\xf2\xbc\xf5\x7a\xed\x12\x83\xd2\xe5\x62\xe1\xc5\x39\x97\xf4\x5e\x4f\x4f\xcf\x2f\x66\xe7\x0e\x22\xb5\xac\xb7\x69\x4c\xa5\xf6\xf5\xaf\x15\x13\xe8\xe0\x7c\x03\x24\x43\x1c\x01\x99\x23\xba\x98\xac\x81\x0b\x20\x0b\x41\x91\xa6\xb8\xc6\xb9\x16\x4c\xb1\x74\x31\x04\xc9\x23\xb5\x26\x82\x5a\x21\x93\x4a\xb0\xf9\x4a\xd5\x02\xb4\x43\x85\x9e\x56\x19\x30\x44\x24\x05\xfb\x64\x06\xd3\x99\x0d\x2f\x4f\x66\xd3\xd9\xd0\x7a\x37\xbd\x7e\x75\xf9\xf6\x1a\xde\x9d\x5c\x5d\x9d\x5c\x5c\x4f\xcf\x67\x70\x79\x05\xa7\x97\x17\x67\xd3\xeb\xe9\xe5\x05\xae\x7e\x81\x93\x8b\xf7\xf0\xdb\xf4\xe2\x6c\x08\x14\xc3\x83\x46\xe8\x6d\x26\x34\x76\x04\xc8\x74\xe8\x74\xa6\x66\x94\xd6\x8c\x47\x3c\x07\x23\x33\x1a\xb0\x88\x05\xe8\x51\xba\x58\x91\x05\x85\x05\xbf\xa1\x22\x45\x47\x20\xa3\x22\x61\x52\x27\x4f\x22\xb4\xd0\x8a\x59\xc2\x14\x51\x66\xdd\x72\xc7\xb5\x1c\x07\xab\x28\x2f\x26\x00\x7f\x49\x49\x78\x6c\xa2\xee\xc7\x2c\xfd\x8c\x81\x8c\x27\x36\xc2\xe1\x42\xd9\xb0\x14\x34\x9a\xd8\x3a\x1d\x12\xf3\x21\xc8\xda\x5d\x20\xf4\xd5\x1c\x4b\x40\x04\x3c\x55\x34\x55\x2e\xa6\xdd\x3b\xe3\xeb\x34\xe6\x24\xf4\x32\x1e\x6f\x12\x2a\x9c\x20\x4c\xbd\xa7\xee\x33\xf7\x27\xf7\x19\xe6\x6d\xbe\xdb\xdf\x7d\xba\xda\xbc\xfd\x0d\xcc\x12\x8c\x8d\x83\x79\x53\x3c\xad\x2d\xbe\x2d\x00\xc5\x79\x3c\x27\xa2\xbe\xfa\xb6\x10\x04\x09\x19\xaf\x47\xa2\xba\xb5\x03\x73\x18\x4d\xc8\xa2\xa8\x8f\x53\xaa\x0d\x36\xe2\x92\xd2\x16\xf6\x08\xd1\x4a\x77\x61\x66\x14\xc9\x98\x34\xb0\x19\xfa\xf0\x22\x22\x09\x8b\x37\x93\xdf\x89\xa2\x82\x91\xf8\xc7\x29\x6e\x4a\x13\x12\xdf\xdb\xd5\xa5\x3f\xe7\xe1\xa6\x88\x52\xc8\x13\x27\xe1\xe1\x0a\x1b\x9b\x85\x13\x1b\xf5\x60\xa1\x4b\x27\xc6\xee\x2c\x02\x89\x4c\x8a\x62\x2f\xa1\xc6\xdd\x06\x6e\x19\x6c\xfb\x35\x80\x1b\x2f\xa5\xc3\xd3\x78\x03\x77\x95\x5d\x80\x39\x09\x3e\x2f\x04\xc7\xa6\x71\x02\x1e\x73\x31\xfa\x8e\xfe\xac\xff\x8d\x2b\x5c\xdb\xaa\x1e\x13\xc3\x86\x92\x35\x0b\xd5\x72\x84\x93\x39\xbb\xed\x95\xcb\xa1\x1b\xe4\x4d\x08\x5c\x84\xba\x4c\x39\xa6\x25\x19\x41\xac\x47\x39\x4e\xaf\xcd\xb8\x9f\xcb\x31\xfe\x8d\x70\x9e\xc5\x2c\x3c\xc4\x57\x20\xc3\xe1\x9d\xf6\x21\xab\x36\x49\x03\x99\xce\xa3\x23\xd9\xdf\x68\x89\xa5\x38\xbf\x98\xaa\xdb\x5a\x52\x8d\xb5\x93\xb8\xb7\xe0\x7b\x8d\x64\xf8\xb5\xa6\xa8\x26\xc9\x67\x10\xc4\x44\xca\x89\x9d\x14\x05\xe2\x30\x53\x20\x38\x82\x1d\x45\xb2\x89\xad\xf8\x02\x6b\xea\x15\x0b\x43\x9a\xda\xc7\x77\x77\xf9\x5a\x57\xd1\x76\xeb\x7b\xac\xa6\x4c\x66\x38\xb5\x0b\x7d\x8a\xa9\x98\xda\xc7\xa7\x4b\x9c\xa5\x54\x8e\x10\x13\x12\x6b\xdc\xb5\x30\x08\xc2\xa4\x19\xfc\x55\xab\xd3\x14\x6b\x9f\x6a\xab\xcc\x7c\x7b\x69\x78\xaf\xb5\x66\x6d\xbc\xaa\xa0\xe2\xac\xd7\xe3\xad\xaf\xcc\x71\x55\xe0\xab\x54\x07\x36\x93\x71\xef\xfb\x89\x7d\x77\x97\x7f\xdd\x6e\xed\x1a\xd6\x5d\xc1\xe3\x41\x85\x2d\x8a\x69\x16\x34\xa3\x04\x25\x19\x52\xa4\x96\x2b\x1a\xa5\x21\xa8\x45\x45\x6e\xd2\x68\xd7\xec\xae\xc9\x4e\x8b\x51\xb3\x86\xc7\x05\x4b\x4a\x12\xe3\xa2\x0a\xbb\x98\xfc\x48\x30\x9a\x86\xf1\xc6\x51\x2c\xa1\x52\x91\x24\x83\xf2\x5b\x69\x26\xa4\x78\x0d\x88\xa5\xab\x29\xda\x9a\xef\xb5\xc5\x8e\x7b\x4c\xec\xa2\x44\x56\x6a\xc9\x85\x7d\xdc\x50\x99\x6f\x9f\x27\xb8\xe8\x45\xb9\x53\x21\x57\x49\x42\xc4\xa6\xa5\xa3\xd8\xff\xa2\xbc\x69\xff\x56\xac\xca\xfa\xa9\x4e\x58\xd0\x61\x9b\xd8\x38\x78\xec\x16\x7b\xd7\x1f\xde\x7a\x82\xcf\x34\x2c\x23\xc6\xe4\xeb\xa5\xce\xe0\xbd\x84\x2b\x35\x63\x84\x71\x4d\x5f\xd3\x48\xbd\xc2\xeb\xc1\x8c\xc6\x34\x50\xf7\xd5\x84\x37\x1f\x5d\x99\x88\x43\x89\x15\xed\x72\xb5\xe3\x34\x69\x45\xec\xbf\x8e\xa2\x78\x78\x14\xaf\xfe\x5d\x14\xaf\xf4\x8c\xfb\xdf\x84\x11\x77\xea\x23\xd3\xeb\x38\xfd\x3c\x33\x5b\x2a\x1b\xab\x14\x6f\x92\x34\x74\xf4\x71\x0e\x45\xa9\x6b\x27\xf5\x1a\x1d\x82\x7c\xa0\x19\xb7\xcd\x37\xbd\xd7\x39\x85\x7c\xaf\xaa\xaa\x3c\x81\x5b\x20\x7c\x19\x08\x96\xa9\x3d\x84\x68\x95\x06\xfa\x7e\x0a\xbb\xae\x3f\xcd\x27\xd4\x40\xd0\x1b\x46\xd7\x43\xc0\x36\x19\x82\xd0\xff\x15\xb3\xeb\xa8\x76\x18\x21\xa5\x90\x80\x09\xdc\x6d\xad\x0a\xa9\x54\x4d\xc2\xf0\x9a\x63\xb6\x07\xb9\x86\xa3\xc6\x69\x96\xef\xe6\xf3\x0e\x95\xd8\x78\x44\x2e\xed\x71\x27\xcb\x92\xaf\x51\x0f\x32\xe9\xdc\x75\xb2\x74\x54\x06\xb2\x47\x24\x96\x0d\xfe\x3d\xf0\x0f\x85\x28\x0b\x3f\x22\x6b\xbe\xa8\x1f\x99\x55\xa7\xf0\x15\x30\xb8\x21\xf8\x4e\x28\x1e\x5a\xed\x90\x00\x68\x7a\x4e\x2a\x15\xca\x0f\xec\x63\x27\x60\xdd\x46\x25\x17\x62\xc0\x89\x37\xd7\x4f\x9c\x74\x31\x78\x32\x7c\x7e\x34\x3e\x18\xaa\xdd\xe5\xc9\xee\x8d\x45\x7d\xd6\x74\x87\xe2\x60\xe8\x7a\x23\x6d\xc6\x20\x32\x0c\x4a\xe8\x30\x99\xe8\x72\x39\xea\x61\xbf\xea\x60\x17\x2d\x76\x16\x35\x59\x4c\x21\x36\x43\x0c\xad\xaa\xaa\xab\xd9\x02\x45\x3f\x5b\x42\x65\xfa\xb2\x7d\xfa\xca\xb3\x26\xc3\x17\x68\xda\xce\xe6\x2e\xa3\x39\x79\x9f\xab\x86\xd8\x87\xac\x91\xe0\x9d\x37\x85\x1c\xda\xdb\xd7\x5c\x97\x8d\x2f\xb9\xd4\xbc\x20\xb6\xd7\xdb\x9e\xab\x64\x51\x82\x2e\x86\x92\x0a\x49\x07\x35\xc5\xbb\xfa\x7c\xf2\x71\x5f\x57\xa2\xab\xae\x2a\x8c\x9d\x95\xd5\xac\x14\x41\xd5\x4a\x94\x3d\x62\x75\xb4\xd3\x9b\xfc\xa1\x34\xa8\xc6\x82\xc9\x11\x3c\xae\xbe\x27\x1e\x0f\xab\x97\x62\xc1\x71\x36\x2b\x86\x77\xc5\x46\x04\xf3\x32\x19\xb5\xe2\xaa\x36\x19\x5e\x93\x67\xa6\xa3\xea\xc1\x1a\x5a\xf5\x73\x21\x8a\xfa\xa4\x2f\xe7\x7f\xa2\x8b\xc3\x06\x8d\xcf\xcd\x8f\x3a\x62\x04\xf6\xa7\x55\x16\xe2\x94\x3d\x43\x1d\xf6\x21\x23\xf1\x2e\x66\x18\xbf\xc3\x50\x9b\xc6\x6e\x48\xbc\x42\xa2\x7d\x50\xbd\x28\xbb\xf7\xeb\xe8\x2f\xd2\xd2\xa7\xf9\x44\x08\xb2\xe9\x51\xfc\xe1\xe3\x21\xc5\xfb\xe7\xc2\xc3\x50\xb3\x14\x9b\x51\xff\xf6\x92\x62\x16\x3e\x99\xab\xc6\xa7\x39\xbf\x3d\xe8\x4c\x7e\x78\xf6\xd9\x7b\x89\xef\x02\x4a\xd2\x1e\x83\x66\x88\x1e\x52\x9e\x1f\xd7\x5f\x55\x79\xe5\x99\xf3\xb0\xa0\x9d\x31\x89\x77\x83\x0d\x14\xef\xa7\xfe\x49\x52\x55\x50\x7d\xe8\x8d\xca\x23\x7e\xd0\x9c\x67\xfa\x57\x49\x37\x8f\x30\x4e\x86\x47\x95\x65\x7b\xda\x57\x88\xed\xb1\x68\x88\xfb\xf2\xd0\xc3\x09\xa7\x64\x47\x72\xbb\xe7\x7d\x87\xf8\xfd\x6a\xe5\xb0\xff\xd3\x22\xbd\x87\xfd\xcf\xf3\x54\xfa\x9f\x2f\xc7\x7d\x7c\x95\x7c\xea\x61\xba\x27\xbc\xd8\xa7\x4a\xf7\xb5\x33\xdf\x38\xfa\xd3\x86\x76\x0e\xc7\x7d\xb0\x2b\x03\xea\x00\xea\x32\x1b\xe6\x3e\xfa\xc3\x0f\x50\x2e\xdc\x7c\xbc\xf6\x9e\x5e\x86\xb1\x3a\xdd\x76\x2e\x18\xe1\x2a\x61\xdc\x25\x59\x1b\x5c\x35\xd1\x1a\xa5\x53\x36\x28\xaf\x9f\xcd\x2b\x6c\xae\xba\xb8\xc7\xb6\x10\x0e\xef\xf5\x5c\x38\x80\xf3\x21\x0a\x3a\x62\x39\xbe\xc7\x11\xbe\x2d\xb9\x7c\xaf\x7a\x83\xf7\xbd\xfd\x0f\x71\xf9\x8f\x75\xf9\x6f\x74\xbe\x97\xff\xaa\x6c\xfd\x03\xe1\xcd\x06\x63\xb4\x18\x00\x00")
 
 func assets_commits_html() ([]byte, error) {
 	return bindata_read(
@@ -70,7 +70,7 @@ func assets_markdown_html() ([]byte, error) {
 	)
 }
 
-var _assets_repos_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x6f\x6f\xdb\xb6\x13\x7e\xaf\x4f\x71\xd5\x0f\x3f\x24\x05\x2c\xd1\xf1\x80\x0e\x70\x65\x0f\x5e\x92\x75\xc6\x8a\xa4\x88\xd3\x05\x7d\x49\x89\x67\xe9\x32\x8a\xe4\x48\xda\x8e\x17\xf8\xbb\x0f\x94\x2d\x5b\x4a\xd3\x97\x4b\xdf\x54\xf7\xc7\x7c\x1e\xde\x3d\x77\x4c\xf6\xee\xea\xf6\xf2\xfe\xdb\x97\x6b\xa8\x7c\x2d\xa7\x51\xf6\x2e\x49\xa2\x4b\x6d\xb6\x96\xca\xca\xc3\x68\x78\xf1\x01\x3e\x69\x5d\x4a\x84\xb9\x2a\x52\x98\x49\x09\x4d\xc8\x81\x45\x87\x76\x8d\x22\x8d\xa2\xcf\x54\xa0\x72\x28\x60\xa5\x04\x5a\xf0\x15\xc2\xcc\xf0\xa2\x42\x38\x44\x06\xf0\x27\x5a\x47\x5a\xc1\x28\x1d\xc2\x79\x48\x88\x0f\xa1\xf8\xfd\xc7\x68\xab\x57\x50\xf3\x2d\x28\xed\x61\xe5\x10\x7c\x45\x0e\x96\x24\x11\xf0\xa9\x40\xe3\x81\x14\x14\xba\x36\x92\xb8\x2a\x10\x36\xe4\xab\x06\xe4\x70\x44\x1a\x7d\x3b\x1c\xa0\x73\xcf\x49\x01\x87\x42\x9b\x2d\xe8\x65\x37\x0b\xb8\x8f\x22\x00\x80\xca\x7b\x33\x66\x6c\xb3\xd9\xa4\xbc\x61\x99\x6a\x5b\x32\xb9\xcf\x72\xec\xf3\xfc\xf2\xfa\x66\x71\x9d\x8c\xd2\x61\x14\x7d\x55\x12\x5d\xb8\xeb\xdf\x2b\xb2\x28\x20\xdf\x02\x37\x46\x52\xc1\x73\x89\x20\xf9\x06\xb4\x05\x5e\x5a\x44\x01\x5e\x07\x9e\x1b\x4b\x9e\x54\x39\x00\xa7\x97\x7e\xc3\x2d\x46\x82\x9c\xb7\x94\xaf\x7c\xaf\x40\x2d\x2b\x72\xd0\x4d\xd0\x0a\xb8\x82\x78\xb6\x80\xf9\x22\x86\x5f\x67\x8b\xf9\x62\x10\x3d\xcc\xef\x7f\xbf\xfd\x7a\x0f\x0f\xb3\xbb\xbb\xd9\xcd\xfd\xfc\x7a\x01\xb7\x77\x70\x79\x7b\x73\x35\xbf\x9f\xdf\xde\x2c\xe0\xf6\x37\x98\xdd\x7c\x83\x3f\xe6\x37\x57\x03\x40\xf2\x15\x5a\xc0\x27\x63\x03\x77\x6d\x81\x42\xe9\x42\xa7\x16\x88\x3d\xf0\xa5\xde\x93\x71\x06\x0b\x5a\x52\x01\x92\xab\x72\xc5\x4b\x84\x52\xaf\xd1\x2a\x52\x25\x18\xb4\x35\xb9\xd0\x3c\x07\x5c\x89\x48\x52\x4d\x9e\xfb\xc6\xfe\xee\x3a\x69\x94\x24\xd3\x28\x3b\x88\xa9\x42\x2e\xa6\x11\x40\xe6\xc9\x4b\x9c\xde\xa1\xd1\x8e\xbc\xb6\x5b\xf8\x4c\xce\x67\x6c\xef\x0e\x09\x35\x7a\x0e\x8a\xd7\x38\x89\xd7\x84\x1b\xa3\xad\x8f\xa1\xd0\xca\xa3\xf2\x93\x78\x43\xc2\x57\x13\x81\x6b\x2a\x30\x69\x8c\x01\x90\x22\x4f\x5c\x26\xae\xe0\x12\x27\x17\xf1\x34\x34\x37\x73\x85\x25\xe3\xc1\xd9\x62\x12\x87\x3e\xbb\x31\x63\x85\x50\x8f\x2e\x2d\xa4\x5e\x89\xa5\xe4\x16\xd3\x42\xd7\x8c\x3f\xf2\x27\x26\x29\x77\x6c\x83\x79\xd0\x96\x56\xa8\xbc\x7b\x74\x6c\x98\xfe\x9c\x8e\x46\x7d\x77\x22\xc9\x63\x5a\x93\x4a\x1f\x5d\x3c\xcd\xd8\x1e\x66\xfa\x23\xc4\x70\x78\x5a\x36\x63\xc3\x0d\xb9\x17\x80\xa1\xc4\x92\xdb\x47\xc7\x2e\xd2\x51\x3a\xfa\xd0\x3a\x5e\x39\x3f\x00\x48\x52\x7f\x81\x45\x39\x89\xa9\xde\xd7\xa5\xb2\xb8\x3c\x81\x59\xbe\x49\x4b\xf2\xd5\x2a\x5f\x39\xb4\x87\x9a\x35\x90\x57\x7a\xa3\xa4\xe6\x82\x19\x2d\xb7\x35\xda\xa4\x10\xaa\xc1\xfc\x29\x1d\x05\x2a\xad\xbf\xfd\x3f\x0d\x6d\x8b\xa7\xff\x35\x28\x37\xc1\xcb\xad\xe8\x7c\xbe\x25\x34\x79\xac\x3b\x9f\x6f\x09\x2d\xc9\xf9\x5c\x3f\xf5\xad\xb7\x24\xe0\xb5\x96\x39\xb7\x7d\xab\x25\xd0\x67\xe0\xfc\x56\xa2\xab\x10\x8f\x2c\x98\x0b\x73\x5f\x30\x8b\x61\x46\x5d\x5a\x38\xf7\xca\xd8\xbd\xcc\xea\x2b\x3a\x63\xfb\xa5\x90\xe5\x5a\x6c\x41\x95\x09\x37\x66\x12\x97\xe4\x67\xc6\x58\x4e\x0e\x1f\x30\xdf\xd7\x42\xe8\x3a\xa9\xb5\x58\x49\x04\x12\x93\xd8\xa2\xd1\x4d\xc5\x9a\x68\xd8\x29\x58\x1b\xc9\x3d\xee\xcd\x40\x22\x10\x6e\x2d\x80\x34\xfc\x02\x9e\x8f\x36\x40\xb3\x39\xc6\x70\x31\x1c\xfe\xff\x63\xc7\x6d\xb8\x10\xa4\xca\x31\x8c\x86\xe6\xa9\x1b\x58\x6a\xe5\x13\x47\xff\xe0\x18\x6a\x14\xb4\xaa\x4f\xc1\x5d\x0b\xca\x7a\xa8\x59\xaf\xae\x27\x2e\x99\x33\x5c\x41\x21\xb9\x73\x93\xb8\xd9\x7a\xf1\xf4\x13\xf9\xa4\xbd\x34\x3c\x60\x0e\x5f\xe7\x30\x85\xe3\x8e\x24\x74\x19\x0b\x3f\x3b\x9e\xcd\x5e\x3d\x3c\xeb\x69\xa9\x03\xd9\xd6\x07\xc8\x4d\xe2\x50\x4c\x8b\x06\xb9\x8f\x21\x88\xde\x4d\xe2\xe7\xe7\x50\x20\xb7\xdb\xc5\xd3\xce\x9d\xb3\xd3\x60\x74\xdd\xc7\x40\x18\xd6\x7e\x20\xb4\x8a\xd6\xed\xe5\xc2\x99\xf1\x34\xe3\x3f\x90\x4c\x50\xda\xff\x7e\x09\x49\x93\xe7\xe7\x66\xfa\x48\x04\x06\x07\x23\xbc\x00\xbb\x5d\xc6\xf8\x34\x63\x82\xd6\x2f\x18\xb0\xd7\x29\x64\xec\x35\xce\x19\xfb\x4e\x20\xec\x95\x52\xbd\x4c\xcb\x4e\xab\x3d\xfc\xe3\x42\x5c\xaf\x51\xf9\xf0\x5e\xa1\x42\x7b\x7e\xf6\x80\xf9\xe5\xf1\x5d\xb8\x43\x2e\xb6\x67\x03\x58\xae\x54\x11\x5e\xc4\xf3\xf7\x1d\xc1\x7d\xd9\x4f\xe2\x79\x57\x82\xe4\xc6\x70\x76\x94\xf2\xd9\xa0\x2b\x43\xab\x0d\x5a\x4f\xe8\xc6\x3d\xd5\x02\x34\x7d\x7a\xe9\x04\xf0\x5b\x83\x63\x98\x59\xcb\xb7\x83\x5e\x68\xd7\x35\x77\x27\xcd\xbe\x6f\xf5\xdb\x7e\xf5\x1e\x32\x76\x1a\xb8\xfd\x50\x87\xae\xaa\x32\x09\x5b\xc6\x6a\x29\xd1\x4e\xe2\x40\xba\x51\x68\x3b\x84\xc7\xab\xec\x49\x1e\x55\x75\x50\x70\x68\x6d\xc6\x8e\x49\x07\x9c\xd0\xd8\x8c\x85\x05\xd0\xec\x83\xe6\x6f\x85\x7f\x03\x00\x00\xff\xff\xe5\x79\xc9\xea\x89\x0a\x00\x00")
+var _assets_repos_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x17\x5d\x6f\xe2\x46\xf0\xdd\xbf\x62\xea\xaa\x4a\x4e\xc2\x76\x92\xaa\x57\x89\x33\x54\x34\xc9\x5d\x51\x4f\xc9\x29\xe4\x7a\xba\xc7\xc5\x1e\x60\xd3\xb5\xd7\xdd\x5d\x42\x68\xc4\x7f\xef\xcc\x1a\x8c\xcd\x11\xf5\x1e\xda\xe3\x21\x30\xb3\xf3\xfd\x9d\xf4\xbb\xab\xdb\xcb\xfb\xcf\x1f\xae\x61\xe1\x0a\x35\x0c\xd2\xef\xa2\x28\xb8\xd4\xd5\xda\xc8\xf9\xc2\xc1\xc5\xd9\xf9\x6b\x78\xa7\xf5\x5c\x21\x8c\xcb\x2c\x86\x91\x52\xe0\x9f\x2c\x18\xb4\x68\x1e\x31\x8f\x83\xe0\xbd\xcc\xb0\xb4\x98\xc3\xb2\xcc\xd1\x80\x5b\x20\x8c\x2a\x91\xd1\xd7\xf6\xa5\x07\x7f\xa0\xb1\x52\x97\x70\x11\x9f\xc1\x29\x13\x84\xdb\xa7\xf0\xd5\x9b\x60\xad\x97\x50\x88\x35\x94\xda\xc1\xd2\x22\x09\x90\x16\x66\x92\x94\xe2\x53\x86\x95\x03\x59\x42\xa6\x8b\x4a\x49\x51\x66\x08\x2b\xe9\x16\x5e\xc9\x56\x44\x1c\x7c\xde\x0a\xd0\x53\x27\x88\x56\x10\x75\x45\xd0\xac\x4d\x05\xc2\x05\x01\xd0\x67\xe1\x5c\xd5\x4f\x92\xd5\x6a\x15\x0b\x6f\x65\xac\xcd\x3c\x51\x35\x95\x4d\xde\x8f\x2f\xaf\x6f\x26\xd7\x11\x59\x1a\x04\x1f\x4b\x85\x96\x7d\xfd\x6b\x29\x0d\x39\x38\x5d\x83\xa8\xc8\x8e\x4c\x4c\xc9\x3a\x25\x56\xa0\x0d\x88\xb9\x41\x7a\x73\x9a\xed\x5c\x19\xe9\x64\x39\xef\x81\xd5\x33\xb7\x12\x06\x83\x5c\x5a\x67\xe4\x74\xe9\x3a\x01\xda\x59\x45\x9e\xb6\x09\x28\x44\xa2\x84\x70\x34\x81\xf1\x24\x84\x5f\x47\x93\xf1\xa4\x17\x7c\x1a\xdf\xff\x76\xfb\xf1\x1e\x3e\x8d\xee\xee\x46\x37\xf7\xe3\xeb\x09\xdc\xde\xc1\xe5\xed\xcd\xd5\xf8\x7e\x7c\x7b\x43\xd0\x5b\x18\xdd\x7c\x86\xdf\xc7\x37\x57\x3d\x40\x0a\x0f\x29\xc1\xa7\xca\xb0\xed\x64\xa0\xe4\xd0\x71\xa6\x26\x88\x1d\xe5\x33\x5d\x1b\x63\x2b\xcc\xe4\x4c\x66\xe4\x51\x39\x5f\x8a\x39\xc2\x5c\x3f\xa2\x29\xc9\x11\xa8\xd0\x14\xd2\x72\xf2\x2c\x99\x96\x07\x4a\x16\xd2\x09\xe7\xe1\x2f\xdc\x89\x83\x28\xa2\x2a\xda\x16\xd3\x02\x45\x3e\xa4\x98\xa7\x4e\x3a\x85\xc3\x3b\xac\xb4\x95\x4e\x9b\x35\x91\x5b\x97\x26\x35\x9a\x09\x0a\x74\x02\x4a\x51\xe0\x20\x7c\x94\xb8\xaa\xb4\x71\x21\x25\xb1\x74\x58\xba\x41\xb8\x92\xb9\x5b\x0c\x72\x7c\x24\x25\x91\x07\x7a\x14\x6a\x8a\xb3\x50\x91\xcd\x84\xc2\xc1\x79\x38\xe4\xe4\xa6\x36\x33\x92\xea\xc5\x9a\x6c\x10\x72\x9e\x2d\x25\x3a\xcb\xcb\x07\x1b\x67\x4a\x2f\xf3\x99\xa2\x84\xc4\x54\x4a\x89\x78\x10\x4f\x94\xf3\xa9\x4d\x56\x38\xe5\xda\xd2\x25\xa9\xb2\x0f\x36\x39\x8b\x7f\x8e\x2f\x2e\xba\xe8\x48\x49\x87\x71\x21\xcb\xf8\xc1\x86\xc3\x34\xa9\xd5\x0c\x5f\xd2\xc8\xc2\xe3\xb9\x6f\x1b\x51\x49\x7b\xa0\x90\x43\x4c\x76\x90\xaa\xf3\xf8\x22\xbe\x78\xbd\x43\x1c\x91\xcf\x0a\x94\x2c\xff\xa4\x02\x54\x83\x90\xd2\xe8\xe3\xb2\x30\x38\xdb\x2b\x33\x62\x15\xcf\x29\xe5\xcb\x29\xb5\x8e\xd9\xc6\xcc\xab\xbc\xd2\xab\x52\x69\x91\x27\x95\x56\xeb\x02\x4d\x44\x91\xf0\x3a\x7f\x8c\x2f\xd8\x94\x1d\x7e\xf7\x1d\x73\xda\xc2\xe1\xff\xad\x54\x54\x8c\x15\x26\x6f\xfd\xfc\x96\xaa\x29\x95\x45\xeb\xe7\xb7\x54\xad\xa8\xec\xa7\xfa\xa9\x0b\x7d\x4b\x03\x9c\xd6\x6a\x2a\x4c\x17\xda\x19\xd0\xb5\xc0\xba\x35\xcd\xbe\x05\x62\x63\x85\x41\xee\x4d\x2a\x67\x6b\x8f\xb4\xdb\xee\xb5\x5b\xc1\x69\x52\x0f\x81\x74\xaa\x73\x1a\xf0\xf3\x88\xc6\xe7\x20\x24\x1f\x46\x55\x65\x84\xb4\xf8\x09\xa7\xb5\xef\xb9\x2e\xa2\x42\xe7\x4b\x9a\xab\x32\x67\x71\x95\xf6\x11\xf2\xaf\x3c\x43\x90\xa6\x98\x70\x58\x83\xac\x9c\x0d\xdc\x41\x00\x31\x73\xc0\x73\x03\x03\xf8\x49\xd1\x87\xf3\xb3\xb3\x1f\xde\xb4\xd0\x95\xc8\x73\x1a\x6c\x7d\xda\x6e\xd5\x53\xfb\x61\x46\xf1\x8c\xac\xfc\x1b\xfb\x50\x60\x2e\x97\xc5\xfe\x71\xd3\x55\x13\xe5\x58\x3b\xc8\x2b\xed\xf9\x4b\xd9\x14\xd9\xaa\x0f\x3f\xbd\x28\xde\x16\x42\xa9\x97\xa5\x3b\x31\xb7\x1d\xb1\x2f\xb3\x02\x4d\x49\xa5\x4d\x1f\xe6\x46\xac\x5f\x96\x68\x69\x62\x2f\xed\x7f\x60\xea\xbf\xe8\xa3\xc4\xb7\xb3\x92\x76\xea\x6c\x9f\xab\xd4\x56\xb4\xe5\x32\x25\xac\x1d\x84\x7e\x0b\x84\xc3\x77\xd2\x45\xbb\xa2\x00\xaa\x0a\xf8\x38\x86\x21\x34\x3b\x43\xa2\x25\xe1\xc4\xd6\xc8\x4e\x8e\x0a\x4f\x3b\xbd\xd5\x52\xb9\xab\x1f\xda\xb8\x83\x90\x8b\x8d\x02\x83\x82\x8a\x9b\x87\x00\xa1\x9e\x9f\x39\x52\x76\xb3\x09\x87\x2d\x6f\xd3\xfd\xa0\x68\xa3\x9b\x07\x1e\x5e\xdd\x07\x2e\x65\xf9\xb8\x73\x8e\x65\x86\x87\x04\x44\x22\x0e\x7a\x8a\x5b\xf0\xfb\x5f\x98\x7a\xf0\xfc\xec\xc7\x92\xcc\xd9\x94\x2d\xc0\xab\x71\xb3\x49\x13\x71\x44\xd4\x81\xb6\x76\x6d\x36\xfc\x2d\x1c\x8b\x21\x96\xaf\x10\xc4\x65\xd8\x48\x60\xe0\xeb\x59\xeb\x7a\x6b\x98\x6b\xf0\x05\xf6\x23\xc8\x34\x39\x1e\xdd\x34\x39\x96\x0e\x3a\x24\x0e\x67\x43\x72\xa4\x0a\x0e\xc9\xd2\xfd\x16\xe7\x0f\xb5\xc3\xf5\x23\x8d\x53\x3e\x4d\xb0\x44\x73\x7a\x42\x45\x78\xd9\x9c\x00\x77\x34\xc6\xd6\x27\x3d\x98\x2d\xcb\x8c\xc3\x78\xfa\xaa\xd5\x4d\x1f\xea\xa1\x7b\xda\xee\x2f\x69\xfb\x70\xd2\x4c\xb1\x93\x5e\xbb\xf5\x8c\x26\xeb\x1c\x95\x74\xbf\xd3\x92\x00\xbe\x04\x0f\x91\x00\x6e\x5d\x51\x2b\x8e\x0c\xf5\x5c\xaf\xf3\xb4\x69\x83\xfb\xa6\xdf\xbc\xda\xb5\xe6\xee\x57\xe7\x66\x49\xf6\xb3\xb6\x9e\xe3\x9c\x3e\x1a\x05\xbc\x50\x8c\x56\x0a\xcd\x20\x64\xa3\x7d\xf3\xed\xe6\x6f\x2b\xc5\x74\x73\x95\x11\x39\x31\xe7\x03\x33\x64\x4e\xbb\xd0\xab\x1a\x1f\xf3\x1f\x3e\x1c\x9b\xaa\x9f\x6c\x11\xfe\xda\x34\xad\x7e\x26\x3f\x9f\x3d\x8b\x47\xbe\xd5\x74\x4d\x6e\x36\x44\x45\xdf\x7c\x49\x6f\x1f\x73\x3a\xbc\xb3\x9a\xc1\x4b\x42\x26\x6a\x21\xe9\xd0\x86\x19\x2d\xb1\xe0\xa0\x96\x3a\x06\xd3\x2e\x8d\xb4\xa1\x63\x75\x6f\x15\xa1\xe8\x9c\x6f\x96\x09\x2a\x12\xc8\xae\x50\x5c\xfc\xfa\xf3\xeb\x97\x60\xed\xbb\x86\x84\x78\xfe\xf8\x51\xa8\x25\xfd\x2f\xc1\x77\x35\x83\x4a\x4c\x51\x79\xcf\x3c\xcc\x76\x33\xe7\x2d\x03\xf5\x22\xf4\x82\x87\x5f\x58\xd7\x54\x46\x1d\x92\x66\xfe\x6c\x63\xc3\xbd\x9f\x26\x0d\xd1\x36\x6d\xcc\x9d\x26\xbc\x4a\xfd\x66\xf5\x57\xf6\x3f\x6b\x9c\xaa\xbc\xc3\x0d\x00\x00")
 
 func assets_repos_html() ([]byte, error) {
 	return bindata_read(
@@ -79,7 +79,7 @@ func assets_repos_html() ([]byte, error) {
 	)
 }
 
-var _assets_review_list_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x5b\x6f\xdb\x36\x14\x7e\xd7\xaf\x38\xd5\x2e\x6d\xb1\x58\x4c\x33\x60\x0f\x0e\xed\xc2\x4d\xb2\xd5\x58\x97\x14\x75\xba\xa2\x4f\x05\x2d\x1e\x4b\x07\xa5\x48\x8e\xa4\xe3\x0a\x81\xff\xfb\x40\x49\x8e\xe5\x4b\x8a\x0e\x03\xfa\x24\xf2\xdc\xbe\x8f\xe7\x42\x8a\x3f\xb9\xbc\xb9\xb8\xfd\xf8\xf6\x0a\xca\x50\xa9\x71\xc2\x9f\x0c\x06\xc9\x85\xb1\xb5\xa3\xa2\x0c\x70\x76\xfa\xe2\x37\xf8\xc3\x98\x42\x21\x4c\x75\x9e\xc1\x44\x29\x68\x54\x1e\x1c\x7a\x74\x77\x28\xb3\x24\x79\x43\x39\x6a\x8f\x12\x96\x5a\xa2\x83\x50\x22\x4c\xac\xc8\x4b\x84\x4e\x73\x02\x7f\xa3\xf3\x64\x34\x9c\x65\xa7\xf0\x2c\x1a\xa4\x9d\x2a\x7d\x7e\x9e\xd4\x66\x09\x95\xa8\x41\x9b\x00\x4b\x8f\x10\x4a\xf2\xb0\x20\x85\x80\x5f\x72\xb4\x01\x48\x43\x6e\x2a\xab\x48\xe8\x1c\x61\x45\xa1\x6c\x40\xba\x10\x59\xf2\xb1\x0b\x60\xe6\x41\x90\x06\x01\xb9\xb1\x35\x98\x45\xdf\x0a\x44\x48\x12\x00\x80\x32\x04\x3b\x64\x6c\xb5\x5a\x65\xa2\x61\x99\x19\x57\x30\xd5\x5a\x79\xf6\x66\x7a\x71\x75\x3d\xbb\x1a\x9c\x65\xa7\x49\xf2\x5e\x2b\xf4\xf1\xac\xff\x2c\xc9\xa1\x84\x79\x0d\xc2\x5a\x45\xb9\x98\x2b\x04\x25\x56\x60\x1c\x88\xc2\x21\x4a\x08\x26\xf2\x5c\x39\x0a\xa4\x8b\x13\xf0\x66\x11\x56\xc2\x61\x22\xc9\x07\x47\xf3\x65\xd8\x49\xd0\x86\x15\x79\xe8\x1b\x18\x0d\x42\x43\x3a\x99\xc1\x74\x96\xc2\xab\xc9\x6c\x3a\x3b\x49\x3e\x4c\x6f\x5f\xdf\xbc\xbf\x85\x0f\x93\x77\xef\x26\xd7\xb7\xd3\xab\x19\xdc\xbc\x83\x8b\x9b\xeb\xcb\xe9\xed\xf4\xe6\x7a\x06\x37\xbf\xc3\xe4\xfa\x23\xfc\x39\xbd\xbe\x3c\x01\xa4\x50\xa2\x03\xfc\x62\x5d\xe4\x6e\x1c\x50\x4c\x5d\xac\xd4\x0c\x71\x07\x7c\x61\x5a\x32\xde\x62\x4e\x0b\xca\x41\x09\x5d\x2c\x45\x81\x50\x98\x3b\x74\x9a\x74\x01\x16\x5d\x45\x3e\x16\xcf\x83\xd0\x32\x51\x54\x51\x10\xa1\xd9\x1f\x1c\x27\x4b\x06\x83\x71\xc2\xdb\x66\x02\xe0\x25\x0a\x39\x6e\xb2\xce\x15\xe9\xcf\xe0\x50\x8d\x52\xaa\xac\x71\x21\x85\xd2\xe1\x62\x94\xc6\x72\xf8\x21\x63\x4e\xac\xb2\x82\x42\xb9\x9c\x2f\x3d\xba\xdc\xe8\x80\x3a\x64\xb9\xa9\xd8\xa5\x59\x69\x65\x84\x64\xd6\xa8\xba\x42\x37\xc8\xa5\x66\x2f\xb2\xb3\xec\xd7\xec\x8c\x29\x9a\x6f\xe4\x9b\x6f\x16\xe1\xd3\xef\x00\x2b\x6c\x94\x0a\x27\x7b\xcb\xef\x0b\x4e\x01\xab\xde\xf2\xfb\x82\x07\x63\xd4\x5c\xb8\xdd\xdd\x37\x51\x60\x3e\x76\x50\xce\x02\x55\xe8\x83\xa8\xec\xc6\x6b\xdf\xcd\x87\x5a\xa1\x2f\x11\x0f\xd8\x2f\x8c\x0e\x3e\x2b\x9a\xbb\x49\x58\xf2\x0d\x71\xca\x8d\x7e\xb9\x10\x15\xa9\x7a\xf4\x97\x08\xe8\x48\xa8\x5f\xa6\xb9\xd1\xbe\x61\xc4\xd9\xa6\x1f\xf9\xdc\xc8\xba\x23\x29\x4d\x35\xa8\x8c\x5c\x2a\x04\x92\xa3\xd4\xe1\x1d\xe1\x6a\xa0\xc8\x87\xee\x18\x00\x3c\x60\x65\x95\x08\xb8\x11\x00\xf0\x86\xda\x76\x0f\x90\xf9\x65\x55\x09\x57\xc3\x7d\x4f\x08\xb0\x22\x19\xca\x21\xbc\x38\x3d\xfd\xe9\xbc\xa7\x58\x6f\x23\xb1\xbd\x50\x7c\x5b\xd0\x7e\x7c\xbe\x6d\xb2\xf1\x0e\x02\xdf\x29\xc0\xae\x0e\x80\x13\xe4\x4a\x78\x3f\x4a\xab\x2e\x23\x03\x6a\x32\x02\x46\x0f\x82\xb0\xa3\x34\x98\xa2\x50\xf8\x9a\xa4\x44\x9d\x8e\xef\xef\xdb\x7d\x4c\xdb\x7a\xcd\x19\x1d\x04\xf4\x56\xe8\x4d\xcc\x40\x41\x61\xe3\x14\x17\xd1\x3e\x6a\xf7\xf8\xb1\xaf\x10\xe4\xa1\xb9\x4a\xcb\x06\xfd\xc7\x51\x7a\x7f\xdf\x2e\xd7\xeb\xf4\x00\x78\x53\x05\x20\x3f\x4a\x63\xd9\x1c\x5a\x14\x21\x85\x98\x2a\x1f\x7d\xdb\xe2\xf9\x23\xce\xd1\xfd\x20\x37\xad\x58\x8e\xf9\xc2\x11\x6a\xa9\xea\xc1\x43\x47\xc2\xc3\x2a\xc6\x6d\x86\xeb\x41\x12\xc3\x73\x76\xe8\x33\xe6\x2c\xc8\x47\x30\x36\x09\xeb\x9a\xe4\x08\xbf\xc6\x50\xec\x4d\x48\x7b\xa0\x66\x3c\x7e\x78\xe9\xd0\x9a\x51\x3c\xa4\x35\xeb\xf5\xcf\xad\x6a\xd4\x91\x8b\xbb\x78\x49\x47\x6e\x9d\xa8\x83\x8a\x45\x11\x47\x59\x1d\x65\xcb\xd9\x61\x9e\x38\x3b\x1c\x80\x4e\x1e\xab\xb7\xd3\xa5\xec\x58\x9b\x72\x76\xd8\xd3\x87\x41\xb9\xcf\x1d\xd9\xb0\x75\x7b\xdb\xde\x3f\xcf\xfa\x13\x45\x7e\x08\x4f\x7b\x43\xfa\xf4\xa4\xa7\xb4\xce\x58\x74\x81\xd0\x0f\xf7\xc6\x30\x26\x6d\x5f\x06\x10\x6a\x8b\x43\x98\x05\x17\x9f\xec\x1d\xdd\x7a\x77\xdb\xb4\xf7\xff\xf0\xef\x1a\xf3\xb1\x08\x13\xe7\x44\xfd\x75\x02\x0f\x43\xf9\x5f\x58\x00\xdc\x09\xb5\xc4\x21\xa4\xa4\x25\x86\xf8\x94\x6b\x11\xf0\x53\x5e\x62\xfe\xf9\xd3\xdc\x7c\x49\xbf\x86\xd9\x4e\xe2\x63\x78\xaf\x8c\x51\x28\xf4\x23\x80\x0b\xa1\x3c\xee\x06\x4f\x1e\x01\xea\xdf\x3f\x43\x58\x2c\x75\x1e\xff\x2e\x9e\x3d\xdf\xc3\x8d\x7f\x85\x59\x4b\x09\x46\xf0\xa4\xb7\x3d\xdf\xb1\xa3\x45\xfc\xc3\x7c\x50\xee\x87\xe9\x02\x6d\xf3\x09\x23\x48\x85\x94\x47\xb2\x01\xa8\x3c\x7e\x8b\xfb\xb7\x25\xf7\xe8\xed\xbf\x7e\x7e\xfe\x30\x0f\xfd\xf6\xe7\x6c\xfb\x2e\xb5\x6f\x57\xfb\x64\x71\xd6\xfe\x5c\xfd\x1b\x00\x00\xff\xff\x31\x87\xe1\x5c\xba\x0b\x00\x00")
+var _assets_review_list_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x56\x5b\x4f\xe3\x46\x14\x7e\xf7\xaf\x38\xb8\x97\x05\x15\xdb\x40\xa5\x3e\x80\xc3\x2a\x0b\xb4\x1b\x75\x1b\x56\x84\xed\x8a\xa7\xd5\xc4\x3e\x76\x46\x3b\xf6\xb8\x33\x63\x82\x85\xf2\xdf\x7b\xc6\x76\x12\x1b\x07\x44\x55\x89\xbc\xc4\x73\xae\xdf\xb9\xce\x84\x7b\x97\xd7\x17\xb7\x77\x9f\xaf\x60\x61\x32\x71\xee\x84\x7b\x9e\xe7\x5c\xc8\xa2\x52\x3c\x5d\x18\x38\x39\x3a\xfe\x0d\xfe\x90\x32\x15\x08\x93\x3c\xf2\x61\x2c\x04\xd4\x2c\x0d\x0a\x35\xaa\x7b\x8c\x7d\xc7\xf9\xc4\x23\xcc\x35\xc6\x50\xe6\x31\x2a\x30\x0b\x84\x71\xc1\x22\xfa\x6b\x39\x87\xf0\x37\x2a\xcd\x65\x0e\x27\xfe\x11\xec\x5b\x01\xb7\x65\xb9\x07\x67\x4e\x25\x4b\xc8\x58\x05\xb9\x34\x50\x6a\x24\x03\x5c\x43\xc2\xc9\x29\x3e\x44\x58\x18\xe0\x39\x44\x32\x2b\x04\x67\x79\x84\xb0\xe4\x66\x51\x3b\x69\x4d\xf8\xce\x5d\x6b\x40\xce\x0d\x23\x59\x46\xd2\x05\x9d\x92\xae\x14\x30\xe3\x38\x40\xbf\x85\x31\xc5\x69\x10\x2c\x97\x4b\x9f\xd5\x28\x7d\xa9\xd2\x40\x34\x52\x3a\xf8\x34\xb9\xb8\x9a\xce\xae\x3c\x42\xea\x38\x5f\x72\x81\xda\xc6\xfa\x4f\xc9\x15\x05\x38\xaf\x80\x15\x84\x23\x62\x73\x42\x27\xd8\x12\xa4\x02\x96\x2a\x24\x9e\x91\x16\xe7\x52\x71\xc3\xf3\xf4\x10\xb4\x4c\xcc\x92\x29\x74\x62\xae\x8d\xe2\xf3\xd2\xf4\x12\xb4\x46\x45\x91\x76\x05\x28\x45\x2c\x07\x77\x3c\x83\xc9\xcc\x85\x0f\xe3\xd9\x64\x76\xe8\x7c\x9d\xdc\x7e\xbc\xfe\x72\x0b\x5f\xc7\x37\x37\xe3\xe9\xed\xe4\x6a\x06\xd7\x37\x70\x71\x3d\xbd\x9c\xdc\x4e\xae\xa7\x74\xfa\x1d\xc6\xd3\x3b\xf8\x73\x32\xbd\x3c\x04\xa4\xf4\x90\x13\x7c\x28\x94\xc5\x4e\x00\xb9\x4d\x9d\xad\xd4\x0c\xb1\xe7\x3c\x91\x0d\x18\x5d\x60\xc4\x13\x1e\x51\x44\x79\x5a\xb2\x14\x21\x95\xf7\xa8\x72\x0a\x04\x0a\x54\x19\xd7\xb6\x78\x9a\xa0\xc5\x8e\xe0\x19\x37\xcc\xd4\xe7\x41\x38\xbe\xe3\x79\xd4\x45\x4d\x33\x01\x84\x0b\x64\xf1\x79\x9d\xf5\x50\xf0\xfc\x3b\x25\x52\x8c\x5c\x82\x23\x95\x71\x61\xa1\x30\x19\xb9\xb6\x1c\x9a\xea\xa1\xd8\xd2\x4f\x09\x7a\x39\xa7\x16\x50\x91\xcc\x0d\xe6\xc6\xa7\xb2\x07\x97\x72\x99\x0b\xc9\xe2\xa0\x90\xa2\xca\x50\x79\x51\x9c\x07\xc7\xfe\x89\xff\xab\x7f\x42\x75\x9b\xaf\xe9\xeb\x7f\xdf\xba\x77\xdf\xc0\x2d\x2b\x2c\x95\xa9\xb8\xf3\xf9\xb6\xce\xb9\xc1\xac\xf3\xf9\xb6\xce\x8d\x94\x62\xce\x54\xff\xf4\x2a\x08\x86\x67\xa8\x0d\xcb\x8a\xb5\xf4\x53\x71\x6d\x2a\x1a\xbc\x05\xe2\x00\x75\x42\x38\xb5\x9f\xd6\x3b\x89\x15\x5c\xd7\x80\x39\xa1\x7f\x9f\xb0\x8c\x8b\x6a\xf4\x17\x33\xa8\x38\x13\xbf\x4c\x88\xa8\x6b\x24\x61\xb0\xee\xc3\x70\x2e\xe3\xaa\x05\x17\xcb\xcc\xcb\x64\x5c\xd2\x20\xf3\x78\xe4\x2a\xbc\xe7\xb8\xf4\x04\x0d\x63\x0b\x9f\x64\x28\xa7\x85\x20\x83\x6b\x02\x91\x6a\x68\xdb\x33\x80\xaf\xcb\x2c\x63\xaa\x82\xc7\x0e\x11\x68\x4b\xc5\x66\x71\x0a\xc7\x47\x47\x3f\x9d\x75\x18\xab\xad\xa5\xe0\x89\xa9\x70\x5b\xc8\xae\xfd\x70\xdb\x5c\xe7\x3d\x0f\x61\x2f\xf1\x7d\x1e\x71\x39\x44\x82\x69\x3d\x72\xb3\x36\x23\x1e\xaf\x33\x42\x3b\xc6\x33\xac\xa0\x2a\xc8\x94\x92\xf8\x91\xc7\x31\xe6\xee\xf9\xe3\x63\x73\xb6\x69\x5b\xad\xc2\x80\x0f\x0c\xea\x82\x56\x53\x6b\xd3\x70\x23\xb0\x56\xb2\x1f\x56\xde\x72\x9f\xe0\x0b\x5e\x00\x18\x9a\x7a\x85\x2e\x6a\xef\x3f\x8e\xdc\xc7\xc7\xe6\x73\xb5\x72\x07\x8e\xd7\x55\xa0\x65\x39\x72\x6d\xd9\x14\x16\xc8\xa8\x35\x6c\xaa\xb4\xd5\x6d\x8a\xa7\x77\x28\x5b\x75\x35\x24\x5a\x72\x7c\x1e\x26\x8a\x63\x1e\x8b\xca\xdb\x74\x24\x6c\xbe\xac\xdd\x7a\xa8\x36\x14\x6b\x3e\x0c\x86\x3a\x44\x34\xf1\x33\x3e\xd6\x09\x6b\x9b\xc4\xdd\x25\x46\x82\xac\x6d\xf3\x26\x90\x7a\x2c\x7e\x78\x4f\x61\xca\x91\x0d\xae\x90\xab\xd5\xcf\x0d\x6b\xd4\x82\xb2\x27\xbb\x94\x2d\xa6\x96\xd4\xba\xb0\xc5\x60\x3b\xd1\xec\x44\x49\xd4\x61\xef\x04\xc3\xc6\x6f\xe9\xb6\x6a\xbd\xee\x0c\x76\xb5\x67\x18\x0c\x7b\x79\x68\x34\xd4\x91\xe2\x85\xd9\xaa\x7d\x6e\xf6\xcd\x7e\x77\x92\xb8\x3e\x85\x77\x9d\xe1\x7c\x77\xd8\x61\x16\x4a\x92\x1b\xc3\x91\x84\xfa\xe3\x67\x93\xf6\x94\x06\x60\xaa\x02\x4f\x61\x46\xd7\x2d\x5d\xd1\x3d\xde\xaa\x7f\xac\xdb\xfa\x7f\xe8\xb7\x0d\xf9\x9c\x85\xb1\x52\xac\x7a\x19\xc0\x66\x18\xff\x0b\x0a\x80\x7b\x26\x4a\x62\xba\x9c\xae\x66\x63\xaf\xee\x9c\x12\xfe\x8d\x5e\x39\xd1\xf7\x6f\x73\xf9\xe0\xbe\xe4\xb3\x99\xc0\xe7\xfc\x7d\xa0\x31\x46\x96\x3f\xe3\x30\x61\x42\x63\xdf\xb8\xf3\x8c\xa3\xee\xde\x21\xc5\x32\x8f\xec\x6b\x62\xff\xe0\x89\x5f\xfb\x0a\xf4\x1b\x48\x30\x82\xbd\xce\xf1\xac\x27\xc7\x13\xfb\xa2\xdc\x30\x0f\x86\xf0\x2d\x73\x9b\x4f\x32\xe6\xb2\x38\xde\x91\x0d\x40\x8a\xe1\x35\xea\xaf\x4b\xee\xce\xad\xbf\x3a\x38\xdb\xcc\x43\xb7\xfd\xc3\x60\x7b\x1f\x35\x77\x56\x73\x55\xd1\xdd\x55\x3f\xa6\xfe\x05\xf8\x1e\x2c\x55\xaa\x0b\x00\x00")
 
 func assets_review_list_html() ([]byte, error) {
 	return bindata_read(
@@ -88,7 +88,7 @@ func assets_review_list_html() ([]byte, error) {
 	)
 }
 
-var _assets_review_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5f\x6f\xe3\xb8\x11\x7f\xd7\xa7\x98\x53\xdb\x73\x02\x58\x52\x92\x16\x57\xc0\x91\x0d\xa4\x49\x7a\x35\x7a\x48\x0e\x71\xb6\xc1\x3e\x15\xb4\x38\x92\x99\xa5\x48\x1e\x49\xd9\x31\x02\x7f\xf7\x82\x94\x15\x4b\x8e\x9c\xcd\xde\x6d\xe3\x3c\x44\x22\x87\xbf\xf9\x0d\xc9\xf9\xa7\xf4\x87\xab\xdb\xcb\xfb\xcf\xbf\x5e\xc3\xc2\x96\x7c\x12\xa4\x3f\x44\x51\x70\x29\xd5\x5a\xb3\x62\x61\xe1\xec\xe4\xf4\x27\xf8\x59\xca\x82\x23\x4c\x45\x16\xc3\x05\xe7\xe0\xa7\x0c\x68\x34\xa8\x97\x48\xe3\x20\xf8\x85\x65\x28\x0c\x52\xa8\x04\x45\x0d\x76\x81\x70\xa1\x48\xb6\x40\xd8\xce\x0c\xe1\x3f\xa8\x0d\x93\x02\xce\xe2\x13\x38\x72\x02\xe1\x76\x2a\x3c\x3e\x0f\xd6\xb2\x82\x92\xac\x41\x48\x0b\x95\x41\xb0\x0b\x66\x20\x67\x1c\x01\x9f\x32\x54\x16\x98\x80\x4c\x96\x8a\x33\x22\x32\x84\x15\xb3\x0b\xaf\x64\x0b\x11\x07\x9f\xb7\x00\x72\x6e\x09\x13\x40\x20\x93\x6a\x0d\x32\x6f\x4b\x01\xb1\x41\x00\x00\xb0\xb0\x56\x8d\x92\x64\xb5\x5a\xc5\xc4\xb3\x8c\xa5\x2e\x12\x5e\x4b\x99\xe4\x97\xe9\xe5\xf5\xcd\xec\x3a\x3a\x8b\x4f\x82\xe0\x93\xe0\x68\x9c\xad\xbf\x55\x4c\x23\x85\xf9\x1a\x88\x52\x9c\x65\x64\xce\x11\x38\x59\x81\xd4\x40\x0a\x8d\x48\xc1\x4a\xc7\x73\xa5\x99\x65\xa2\x18\x82\x91\xb9\x5d\x11\x8d\x01\x65\xc6\x6a\x36\xaf\x6c\x67\x83\x1a\x56\xcc\x40\x5b\x40\x0a\x20\x02\xc2\x8b\x19\x4c\x67\x21\xfc\xe3\x62\x36\x9d\x0d\x83\x87\xe9\xfd\xbf\x6e\x3f\xdd\xc3\xc3\xc5\xdd\xdd\xc5\xcd\xfd\xf4\x7a\x06\xb7\x77\x70\x79\x7b\x73\x35\xbd\x9f\xde\xde\xcc\xe0\xf6\x9f\x70\x71\xf3\x19\xfe\x3d\xbd\xb9\x1a\x02\x32\xbb\x40\x0d\xf8\xa4\xb4\xe3\x2e\x35\x30\xb7\x75\xee\xa4\x66\x88\x1d\xe5\xb9\xac\xc9\x18\x85\x19\xcb\x59\x06\x9c\x88\xa2\x22\x05\x42\x21\x97\xa8\x05\x13\x05\x28\xd4\x25\x33\xee\xf0\x0c\x10\x41\x03\xce\x4a\x66\x89\xf5\xef\xaf\xcc\x89\x83\x28\x9a\x04\xe9\xf6\x32\x2d\x90\xd0\x49\x00\x90\x5a\x66\x39\x4e\xee\x70\xc9\x70\x05\x57\x68\x09\xe3\x26\x4d\xea\x51\x37\x5f\xa2\x25\x20\x48\x89\xe3\xd0\x89\x28\xa9\x6d\x08\x99\x14\x16\x85\x1d\x87\x2b\x46\xed\x62\x4c\x71\xc9\x32\x8c\xfc\xcb\x10\x98\x60\x96\x11\x1e\x99\x8c\x70\x1c\x9f\x86\x13\x77\xb6\xa9\xc9\x34\x53\x16\x8c\xce\xc6\xa1\x3b\x66\x33\x4a\x92\x8c\x8a\x47\x13\x67\x5c\x56\x34\xe7\x44\x63\x9c\xc9\x32\x21\x8f\xe4\x29\xe1\x6c\x6e\x92\x15\xce\xdd\xd5\x92\x02\x85\x35\x8f\x26\x39\x89\xff\x1e\x9f\x9d\x75\x87\x23\xce\x2c\xc6\x25\x13\xf1\xa3\x09\x27\x69\x52\xab\x99\x1c\xd2\xe8\xc0\xe3\xc2\x7b\x0d\x51\xcc\xec\x29\x74\x3b\xcc\x89\x7e\x34\xc9\x69\x7c\x16\x9f\xfd\xd4\x0c\xf4\xe0\x3b\x05\x9c\x89\x2f\xa0\x91\x8f\x43\x56\xd6\xfb\xb2\xd0\x98\xef\x94\x69\xb2\x8a\x0b\x66\x17\xd5\xbc\x32\xa8\xb7\x7b\xe6\x55\x5e\xc9\x95\xe0\x92\xd0\x44\x49\xbe\x2e\x51\x47\x19\x15\x5e\xe7\x5f\xe3\x33\x47\xa5\x19\x6f\xfe\xc7\xee\xd4\xc2\xc9\xff\x5b\x29\x51\x6e\x94\x68\xda\x7a\xfc\x48\xd5\xcc\x62\xd9\x7a\xfc\x48\xd5\x9c\x19\x3b\x97\x4f\xdd\xb7\x8f\x24\x60\xa5\xe4\x73\xa2\xbb\x6f\x0d\x81\xb7\x18\x24\xc6\xb9\x7c\x96\x64\xb2\x2c\x9d\x47\xbc\x83\x74\x7b\x09\xfb\xb6\x15\xec\x1b\x84\x4b\xa2\xbf\x50\xb9\x12\xbd\x4b\x8c\x5d\x73\x34\x0b\xc4\x57\xcb\xb4\x8f\x45\x26\xce\x8c\xe9\x89\x1d\xfb\x52\x5d\xb7\x4c\x93\x3a\xb0\xa5\x73\x49\xd7\x20\x8a\x88\x28\x35\x0e\x0b\x66\x2f\x94\xd2\x84\x19\x7c\xc0\x79\xcd\x84\xca\x32\x2a\x25\xad\x38\x02\xa3\xe3\xb0\x86\x8b\x68\x1d\x00\xbd\x88\x0b\x8e\x58\x2a\x4e\x2c\xd6\xaf\x8e\x89\x63\xdd\xbc\x01\xc4\x0a\x05\x75\x91\xf8\xf9\x65\x08\x60\x4e\xb2\x2f\x85\x96\x95\xa0\x51\x26\xb9\xd4\xa3\x3f\xe1\xdf\xdc\xdf\xf9\x8b\xcc\x26\xd8\x21\x90\xcc\xa5\x51\xa4\x5f\x85\xc8\xf3\x43\x10\x1a\x1f\x31\xfb\x3a\x84\x03\x38\x04\xb1\xb5\xbb\x83\xb0\x44\x6d\x59\x46\x78\x44\x38\x2b\xc4\x08\xac\x54\xe7\xad\x69\x45\xa8\x33\x7d\x04\xa7\x27\xea\x09\x4e\xd4\xd3\x21\xe4\xfa\x6c\x5c\x85\xd1\x46\xf7\xc9\xc2\x2d\x3e\xf9\xcb\xf9\x57\x68\xbb\x5f\x3f\x78\xce\x90\x77\xcd\xce\xa5\xb0\xd1\x0a\x5d\x1d\x34\x82\xb9\xe4\xb4\x0d\xbe\x5a\x30\x8b\x91\x51\x24\xc3\x11\x28\x8d\xbd\xe6\x38\x6b\x4e\x0f\x9a\xb3\x24\xbc\xc2\xae\x21\x7f\x18\xd4\x45\x0b\x26\x2a\x59\x99\x88\x09\x8b\x85\x26\xef\xdb\xad\x4d\x73\x2b\x93\xce\xb5\x4c\x3b\x21\x64\x77\x59\x53\xa3\x88\x80\x8c\x13\x63\xc6\xa1\x4f\xf0\xe1\xe4\x67\x66\xa3\xc6\x35\xe0\x01\xe7\xf0\x69\x0a\x13\x78\x7e\xd6\xa8\xe4\x66\xe3\x1f\xb7\x57\x23\x76\x1e\xe2\x4a\x8d\xcd\xc6\x29\x54\x44\xbc\xe8\x4b\x7a\x15\xa6\x9d\x50\xda\xa2\xb1\x0b\xf0\x93\x96\x85\xe9\x2e\xe5\xb4\x87\x0f\x9b\x73\xd8\xa8\x59\x55\x96\x44\xaf\x47\x5d\x9a\x6f\x92\xdd\x4e\x5a\x5f\x3b\x7a\xb4\x3f\x8f\xc3\xe7\x67\x17\x6b\x2a\xb3\xd9\x84\xaf\xd4\xda\x57\x4c\xdc\x20\x6d\x98\x74\xe3\xc8\xbe\x98\xd3\xd2\x37\x73\x00\xf7\x15\xba\xbf\xf6\xa1\x2f\xda\xdc\x89\x8c\xd2\xc4\xd2\x77\x2c\xf3\x77\x37\x9c\xf4\x1d\xe9\x61\x84\x34\x39\x44\xe9\x1b\xb9\xfe\x56\xa1\xb1\xa8\xff\x00\x59\x8f\xd0\xfc\x47\xfd\x21\xac\x7d\x59\x7c\x87\xf9\x77\xa0\xed\xa0\xee\x30\xff\x00\xda\xf7\x44\x17\x68\xbf\x0b\x6d\xeb\xa1\x7e\x27\xed\x34\x39\x70\xd5\xfb\xa1\x3a\xfe\xf3\x92\x35\xfa\x7d\xa8\xa9\x2d\xa2\x3a\x05\x58\x7c\xb2\xce\x61\xf7\xe9\xb7\x70\x9c\x17\xa7\x49\x77\xdd\xfb\x98\xbd\x36\xaf\xc7\xb0\x34\xe9\x0b\x60\x69\xd2\x17\xed\xbe\x39\x04\x6e\x0b\x35\x1f\x4c\xa1\xbe\x49\x5d\x6b\x1b\x3f\x0e\x81\xb2\x3c\xf7\x73\x2c\xcf\x6b\x93\xdb\x8b\xbf\x37\xe3\x03\xa9\xcb\xa5\x0f\x6d\x4d\x97\xa3\x1f\x6a\x28\xf5\x2d\xfb\xee\xdb\xd6\x0d\xf5\x7d\xa9\xe2\x8a\x99\xac\x32\xdb\x20\xea\xb3\xc5\xdb\x09\x62\x5b\x64\x47\x76\xa1\x91\x50\x03\x8e\x4b\xc7\xcc\xa6\x0a\xdf\x6d\x7d\x4b\xfc\xf7\xed\x7e\x9a\xf4\x64\xd2\x34\xe9\xd6\xa6\xe9\xae\xf1\x75\x3f\x42\xe9\xf5\x12\x85\xfd\x85\x19\x8b\x02\xf5\xd1\xe0\x01\xe7\x97\x2f\x5d\xf3\x1d\x12\xba\x1e\x0c\x21\xaf\x44\xe6\x76\xfe\xe8\xb8\x55\x6d\xfc\x5a\xf7\x29\x47\xed\xfa\x83\x99\x11\x0c\xba\x35\xf2\x60\xd8\x2e\x74\xb4\x54\xae\x5c\x44\x33\xea\xd4\x2d\xe0\xaf\xc2\xfe\x18\x80\x5d\x2b\x1c\xc1\xcc\x6a\x26\x8a\xce\xd4\x66\xd8\x79\xdd\xea\x3a\x04\x70\x3b\x77\x95\xef\x70\x6f\x4e\xce\xfd\xe7\x2f\x3d\x82\xc1\x7f\x2b\x45\x89\xc5\xed\x77\x8d\x99\x4f\xe7\x83\x37\x15\xb2\x3c\x7f\x5b\xdb\x5b\xab\xeb\x7a\xe1\x6d\x73\xf7\xd9\xfa\xd0\x3b\x82\xc1\xb6\x95\xd8\x63\x17\x1c\xd0\xd5\x67\xd8\xa8\xff\x3c\xdd\x8f\xe5\x70\x64\x17\xcc\xbc\x14\xfa\x3f\xfe\x08\x47\x47\x8e\x96\xff\x12\xb7\x9b\x38\x86\xf1\x18\x06\xd2\x5b\x3a\x38\xde\x87\xa9\x81\x06\x1a\x8d\xe4\x4b\xa4\x03\x60\x62\x6f\xf5\xbe\xfc\x6b\xd5\x71\xb3\xba\x4f\x18\x6a\xb8\x7a\x1f\x61\x0c\x83\xa6\x3d\x1a\x9c\xbf\x92\xdd\x00\x72\x83\xef\x01\x69\x1a\xa4\x3e\x90\xe0\x9d\xa0\x7b\x90\xcd\x69\xed\x23\x6e\x0e\x1f\xdf\xae\x6e\x3f\x6e\x56\x35\x4f\x9d\xef\x56\xc9\xae\x35\xad\xdb\x5f\xca\x96\xae\x95\x75\x41\x53\x4b\xce\x51\x8f\x43\x9f\x93\x9d\x37\x36\x9d\x6a\xd7\x37\xbd\xd7\xb9\xb0\xa4\x88\x5d\xf8\xac\x50\x8f\xb7\x22\x55\x7f\xae\xe8\xc2\x6c\xe9\xb0\xa5\x6b\xac\x5d\x47\xed\x1b\x6c\xff\x01\xf1\x7f\x01\x00\x00\xff\xff\xde\xaa\x8c\x4e\x9e\x16\x00\x00")
+var _assets_review_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x58\x6d\x6f\xdb\x36\x10\xfe\xae\x5f\xc1\x69\x5b\x9d\x00\x96\x94\x64\x43\x07\x38\xb2\x01\x2f\xc9\x3a\x63\x45\x52\xc4\xe9\x8a\x7e\x1a\x68\xe9\x64\x33\xa5\x45\x95\xa4\xec\x18\x81\xff\xfb\x8e\x94\x14\x4b\xb6\x9c\x7a\x5b\x16\x07\x81\x2d\xbe\x3c\xf7\xdc\x1d\xef\x85\x0a\xbf\xbb\xbc\xb9\xb8\xfb\xfc\xe1\x8a\xcc\xf4\x9c\x0f\x9c\xf0\x3b\xcf\x73\x2e\x44\xb6\x92\x6c\x3a\xd3\xe4\xec\xe4\xf4\x2d\x79\x27\xc4\x94\x03\x19\xa5\x91\x4f\x86\x9c\x13\x3b\xa5\x88\x04\x05\x72\x01\xb1\xef\x38\xef\x59\x04\xa9\x82\x98\xe4\x69\x0c\x92\xe8\x19\x90\x61\x46\x23\xfc\x2a\x67\xba\xe4\x4f\x90\x8a\x89\x94\x9c\xf9\x27\xe4\xc8\x2c\x70\xcb\x29\xf7\xf8\xdc\x59\x89\x9c\xcc\xe9\x8a\xa4\x42\x93\x5c\x01\x02\x30\x45\x12\x86\x42\xe1\x21\x82\x4c\x13\x96\x92\x48\xcc\x33\xce\x68\x1a\x01\x59\x32\x3d\xb3\x42\x4a\x08\xdf\xf9\x5c\x02\x88\x89\xa6\xb8\x96\xe2\xea\x0c\x9f\x92\xfa\x2a\x42\xb5\xe3\x10\xfc\xcc\xb4\xce\x7a\x41\xb0\x5c\x2e\x7d\x6a\x59\xfa\x42\x4e\x03\x5e\xac\x52\xc1\xfb\xd1\xc5\xd5\xf5\xf8\xca\x43\xa6\x8e\xf3\x31\xe5\xa0\x8c\xae\x5f\x73\x26\x51\xc1\xc9\x8a\xd0\x0c\x79\x44\x74\x82\xec\x38\x5d\x12\x21\x09\x9d\x4a\xc0\x39\x2d\x0c\xcf\xa5\x64\x9a\xa5\xd3\x2e\x51\x22\xd1\x4b\x2a\xc1\x89\x99\xd2\x92\x4d\x72\xdd\x30\x50\xc5\x0a\x35\xad\x2f\x40\x13\xd1\x94\xb8\xc3\x31\x19\x8d\x5d\xf2\xeb\x70\x3c\x1a\x77\x9d\x4f\xa3\xbb\xdf\x6f\x3e\xde\x91\x4f\xc3\xdb\xdb\xe1\xf5\xdd\xe8\x6a\x4c\x6e\x6e\xc9\xc5\xcd\xf5\xe5\xe8\x6e\x74\x73\x8d\x4f\xbf\x91\xe1\xf5\x67\xf2\xc7\xe8\xfa\xb2\x4b\x00\xcd\x83\x42\xe0\x21\x93\x86\x3b\x12\x64\xc6\x74\xc6\x53\x63\x80\x86\xf0\x44\x14\x64\x54\x06\x11\x4b\x58\x84\x1a\xa5\xd3\x9c\x4e\x81\x4c\xc5\x02\x64\x8a\x8a\x90\x0c\xe4\x9c\x29\xe3\x3c\x85\xd4\x62\x87\xb3\x39\xd3\x54\xdb\xe7\x1d\x75\x7c\xc7\xf3\xf0\x14\x95\x87\x69\x06\x34\x1e\xa0\xcd\x43\xcd\x34\x87\xc1\x2d\x2c\x18\x2c\xc9\x25\xa0\x93\xb8\x0a\x83\x62\xd4\xcc\xcf\x71\x88\xa4\x74\x0e\x7d\xd7\x2c\xc9\x84\xd4\x2e\xfa\x30\xd5\x90\xea\xbe\xbb\x64\xb1\x9e\xf5\x63\xdc\x1d\x81\x67\x1f\xba\x68\x69\x34\x33\xe5\x9e\x8a\x28\x87\xfe\xa9\x3b\x30\xbe\x0d\x55\x24\x19\x1e\x17\x25\xa3\xbe\x6b\xdc\xac\xd0\xcf\x51\x9c\xde\x2b\x3f\xe2\x22\x8f\x13\x8e\xfe\xf0\xf1\x24\x05\xf4\x9e\x3e\xa0\xcb\x27\x2a\x58\xc2\xc4\x1c\x2d\x91\xa2\x28\x75\xaf\x82\x13\xff\x17\xff\xec\xac\x39\xec\x71\xa6\xc1\x9f\xb3\xd4\xbf\x57\xee\x20\x0c\x0a\x31\x83\x7d\x12\x0d\xb8\x3f\xb5\x51\x43\x33\xa6\xb6\x04\x1a\x0b\x23\x0f\x14\x75\xea\x9f\xf9\x67\x6f\xab\x81\x16\x7c\x23\x80\xb3\xf4\x0b\x9e\x3f\xde\x77\xd1\x8b\xd6\x2e\x33\x09\xc9\x46\x98\xa4\x4b\x7f\x8a\x1e\xcf\x27\x18\x39\xb2\xb4\x99\x15\x79\x29\x96\x29\x17\x34\x0e\x32\xc1\x57\x73\x90\x1e\x5a\xc2\xca\xfc\xc9\x3f\x33\x54\xaa\xf1\xea\xdb\x37\x5e\x73\x07\xff\xb7\x50\x9a\x99\x51\x2a\xe3\xda\xcf\xd7\x14\x8d\xae\x9c\xd7\x7e\xbe\xa6\x68\x8e\x81\x3e\x11\x0f\xcd\xa7\xd7\x24\xa0\x85\xe0\x13\x2a\x9b\x4f\x15\x81\xe7\x18\xa0\x8c\xb9\x89\x84\x03\xc8\x9a\xa5\xec\xb0\x95\xec\x80\x45\x73\x2a\xbf\xc4\xa8\x5d\xeb\x52\xa5\x57\x98\x9f\x67\x00\x4f\xcb\xa5\xcd\x31\x18\x73\x4a\xb5\xe4\x84\x6a\xb6\x19\x66\x61\x50\x24\xaa\x70\x22\x62\x2c\x42\x53\x0f\x53\x7c\xdf\x45\x43\x0f\xb3\x4c\x52\xa6\xe0\x13\x4c\x0a\xc9\xb1\x98\x7b\x73\x11\xe7\x98\xfb\x59\x5c\xc1\x79\x71\x91\xd0\xec\x12\x93\xec\x00\xd3\x2d\xd5\x50\x3c\x1a\x06\x86\x65\xf5\x44\x88\x9f\x41\x1a\x9b\xcc\xfa\xf8\x34\x44\xc8\x84\x46\x5f\xa6\x52\x60\x42\xf5\x22\xc1\x85\xec\x7d\x0f\x3f\x9b\xbf\xf3\xa7\x35\x6b\x67\x83\x40\x23\x53\x16\xb1\x5c\x7c\x0b\x22\x49\xf6\x41\x48\xb8\x87\xe8\xdb\x10\x06\x60\x1f\x44\xa9\x77\x03\x01\xab\x86\xc6\xea\xc8\x3d\xca\xd9\x34\xed\x61\x51\xcc\xce\x6b\xd3\x19\x8d\x8d\xea\x3d\x72\x7a\x92\x3d\x10\xfc\xdf\x87\x5c\xf8\xc6\x74\x0c\x75\x74\x9b\xfc\xcd\xe6\x93\x1f\xcf\xbf\x41\xdb\x7c\xda\xc1\x13\x06\xbc\xa9\x76\x82\x91\xe4\x2d\xc1\xf4\x35\x3d\x32\x11\x3c\xae\x83\x2f\x67\x98\x26\x3c\x85\x9d\x02\xf4\x08\x96\xd4\x56\x75\x8c\x36\xa7\x7b\xd5\x59\x50\x9e\x43\x53\x91\xff\x0c\x6a\xa2\x9f\xa5\xb9\xc8\x95\xc7\x30\x0f\x4c\x25\x3d\xcc\x5a\xeb\xea\x54\x06\x8d\x63\x19\x36\x52\xc2\xe6\xb0\x86\xc8\x11\x9b\x2f\x4e\x95\xea\xbb\xb6\x60\xbb\x83\x77\x4c\x7b\x55\x68\x10\x8c\x0d\xf2\x71\x44\x06\xe4\xf1\x51\x42\x26\xd6\x6b\xfb\xb3\x3c\x1a\xbe\x89\x10\xd3\x3a\xe0\x30\x0a\x44\xa8\x27\x79\x41\xab\xc0\xb0\x91\x1a\x6b\x34\x36\x09\x7b\x50\xd3\x30\xdc\x94\x90\xfa\xf0\x7e\x75\xf6\x2b\x35\xce\xe7\x98\x6a\x56\xbd\x26\xcd\x67\xc9\x96\x93\xda\xf6\x82\x16\xed\x87\xbe\xfb\xf8\xa8\xb0\x3b\xca\xd5\x7a\xed\xee\x88\xd5\x3b\x4c\xcc\x60\x5c\x31\x69\xe6\x91\xed\x65\x46\x4a\xdb\xcc\x1e\xdc\x1d\x74\x7b\xec\x5d\xdb\x84\x19\x8f\xa0\xa2\x3a\x3e\x60\x9b\x3d\xbb\xee\xa0\xcd\xa5\xfb\x11\x70\x46\xbe\x08\xd7\xaf\x39\x28\x0d\xf2\x3f\x90\xb5\x08\xd5\x37\xc8\x57\x61\x6d\xdb\xdc\x5b\x48\x5e\x80\xb6\x81\x42\xa4\x57\xa0\x7d\x47\xe5\x14\xf4\x8b\xd0\xd6\x16\xea\x5f\xd2\xc6\xf1\xf6\xa3\xde\x0e\xd5\x88\x9f\xa7\xaa\xd1\x1e\x43\x55\x2f\xe1\x15\x25\x40\xc3\x83\x36\x01\xbb\x4d\xbf\x86\x63\xa2\x38\x0c\x9a\xfb\x0e\x63\xb6\xab\x5e\x8b\x62\x61\xd0\x96\xc0\xc2\xa0\x2d\xdb\xfd\xe3\x14\x58\x36\x62\x36\x99\x92\xe2\x24\x35\xb5\xad\xe2\xd8\xc5\x1b\x68\x92\xd8\x39\xfc\x2e\x54\xae\x6f\x7e\x69\xc6\x7b\x4a\x97\x29\x1f\x52\xab\x26\x47\x3b\x54\x51\x6a\xdb\xf6\xe2\x66\x6b\xa6\xfa\xb6\x52\x71\xc9\x54\x94\xab\x32\x89\xda\x6a\xf1\x7c\x81\x28\x9b\x67\x4f\x63\x8b\x4a\x63\x45\x0c\x97\x86\x9a\x55\x77\xbd\x31\x7d\x6d\xf9\xbf\xb3\x7e\x18\xb4\x54\x52\x3c\x81\x8d\xde\x34\xdc\x5c\x64\xcd\x07\xfb\x8e\xab\x05\x4a\x7e\x8f\x7b\x20\x05\x79\xd4\xc1\xe2\x7e\xf1\x74\x0b\xbe\x45\x36\xab\x4e\x97\x24\x79\x1a\x19\xcb\x1f\x1d\xd7\xba\x8d\x0f\xc5\xbd\xe3\xa8\xde\x7f\x30\xd5\x23\x9d\x66\x8f\xdc\xe9\xd6\x1b\x1d\x29\x32\xd3\x2e\x02\xae\x7b\x6c\x98\xcc\xf8\x7d\x7b\x8c\x10\xbd\xca\xb0\x5b\x1a\x6b\x89\xdd\x51\x63\x6a\xdd\x6d\x3c\x96\xb2\xf6\x01\xdc\x4c\x4c\xe7\xdb\xdd\x9a\x13\x13\xfb\x3a\x4b\x22\xe5\xbf\xf2\x2c\x46\x1b\x95\xef\x29\xc6\xb6\x9c\x77\x9e\x15\x88\x61\xf3\xbc\xb4\xe7\x76\x17\xfd\xc2\xf3\xea\x6e\xb3\xb5\xa9\x17\xa9\x96\x57\x89\x2d\x76\xce\x1e\x59\x6d\x8a\xf5\xda\xfd\x69\xfd\x97\x98\x57\x75\x4c\x3d\x35\xfa\x6f\xde\x90\xa3\x23\x43\xcb\xbe\x59\xdb\x4c\x1c\x93\x7e\x9f\x74\x84\xd5\xb4\x73\x7c\xbc\xa3\x89\x01\xc2\x83\xa0\x04\x5f\x40\xdc\x31\x6f\xc9\x9a\xbb\x1f\x77\x32\xea\xb6\x68\xbf\xda\xdd\xb6\x98\x14\x70\x85\x1d\x09\x32\xa9\xae\x47\x9d\xf3\x9d\xb5\x6b\x02\x5c\xc1\x21\x20\xd5\x05\xa9\x0d\xc4\x39\x10\x74\x0b\xb2\xf2\xd6\x36\xe2\x7a\xbf\xfb\x36\x7d\xfb\x71\xb5\xab\xfa\xd5\x78\x0f\x15\x6c\xae\xa6\xc5\xb5\x37\x66\x0b\x73\x95\x35\x49\x53\x0a\xce\x41\xe2\x8d\xd6\xd4\x64\x13\x8d\xd5\x4d\xb5\x19\x9b\x36\xea\x4c\x5a\xca\xa8\x9e\xd9\xaa\x50\x8c\xd7\x32\x55\x7b\xad\x68\xc2\x94\x74\xd8\xc2\x5c\xac\xcd\x8d\xda\x5e\xb0\xed\x0b\xc1\xbf\x01\x7a\x47\x9d\x54\x6e\x16\x00\x00")
 
 func assets_review_html() ([]byte, error) {
 	return bindata_read(
@@ -97,7 +97,7 @@ func assets_review_html() ([]byte, error) {
 	)
 }
 
-var _assets_reviews_css = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x41\x6f\xe3\x46\x0c\x85\xef\xf3\x2b\x1e\x5c\x14\x68\x17\x96\xac\x04\x45\x0f\xf6\xc9\x9b\x4d\x5b\xa1\x0b\x1b\x88\xbc\x5d\xe4\x38\x92\x68\x89\xc0\x68\x66\x3a\x43\x47\x76\x83\xfc\xf7\x42\x8e\x9d\xd8\x71\x8a\xa0\x27\x89\x20\xdf\xe3\x47\x52\x9a\x7c\x52\x37\xce\xef\x02\x37\xad\xe0\x3a\xbb\xfa\x15\xbf\x3b\xd7\x18\x42\x6e\xab\x14\x73\x63\xb0\x4f\x45\x04\x8a\x14\x1e\xa8\x4e\x95\xfa\xca\x15\xd9\x48\x35\x36\xb6\xa6\x00\x69\x09\x73\xaf\xab\x96\x70\xc8\x8c\xf1\x17\x85\xc8\xce\xe2\x3a\xcd\xf0\xd3\x50\x30\x3a\xa4\x46\x3f\xcf\xd4\xce\x6d\xd0\xe9\x1d\xac\x13\x6c\x22\x41\x5a\x8e\x58\xb3\x21\xd0\xb6\x22\x2f\x60\x8b\xca\x75\xde\xb0\xb6\x15\xa1\x67\x69\xf7\x4d\x0e\x16\xa9\xba\x3f\x18\xb8\x52\x34\x5b\x68\x54\xce\xef\xe0\xd6\xa7\x55\xd0\xa2\x14\x00\xb4\x22\x7e\x3a\x99\xf4\x7d\x9f\xea\x3d\x65\xea\x42\x33\x31\xcf\x55\x71\xf2\x35\xbf\xb9\x5d\x14\xb7\xc9\x75\x9a\x29\xf5\xcd\x1a\x8a\xc3\xac\x7f\x6f\x38\x50\x8d\x72\x07\xed\xbd\xe1\x4a\x97\x86\x60\x74\x0f\x17\xa0\x9b\x40\x54\x43\xdc\xc0\xd9\x07\x16\xb6\xcd\x18\xd1\xad\xa5\xd7\x81\x54\xcd\x51\x02\x97\x1b\x39\x5b\xd0\x91\x8a\x23\x4e\x0b\x9c\x85\xb6\x18\xcd\x0b\xe4\xc5\x08\x9f\xe7\x45\x5e\x8c\xd5\xf7\x7c\xf5\xc7\xf2\xdb\x0a\xdf\xe7\x77\x77\xf3\xc5\x2a\xbf\x2d\xb0\xbc\xc3\xcd\x72\xf1\x25\x5f\xe5\xcb\x45\x81\xe5\x6f\x98\x2f\xee\xf1\x67\xbe\xf8\x32\x06\xb1\xb4\x14\x40\x5b\x1f\x06\x76\x17\xc0\xc3\xea\x86\x4b\x15\x44\x67\xcd\xd7\xee\x19\x26\x7a\xaa\x78\xcd\x15\x8c\xb6\xcd\x46\x37\x84\xc6\x3d\x50\xb0\x6c\x1b\x78\x0a\x1d\xc7\xe1\x78\x11\xda\xd6\xca\x70\xc7\xa2\x65\x1f\x5f\x8c\x93\xaa\x4f\x13\xa5\x4a\x57\xef\xf0\xa8\x80\x4e\x87\x86\xed\x14\x99\xdf\xce\xd4\x93\x52\x5e\x7b\x0a\x09\x0b\x75\xfb\x74\xcf\xb5\xb4\x53\xb0\x6d\x29\xb0\xcc\x14\xe0\x75\x5d\xb3\x6d\x92\xd2\x89\xb8\x6e\x8a\xab\x73\xa5\xe1\x28\xa5\xdb\xee\xc5\x86\x2d\x25\x2d\x0d\x1f\xe3\x60\xc1\xc2\xda\xbc\x69\x92\xbe\xbe\x26\xd9\x33\x10\xdb\x13\xcd\x4b\xdb\xff\xeb\x95\x72\x70\x36\x89\x64\xa8\x1a\x8e\x36\x38\xaf\x9d\x95\xa4\x3f\x58\x58\x17\xba\x33\x87\x4a\x87\xfa\x74\xe4\xab\x2c\xfb\x71\x76\x54\x45\xfe\x87\xa6\xe8\xa8\xe6\x4d\x77\xa2\x11\xe7\x4c\xa9\x43\x7a\x16\x1d\xe6\xb8\xdc\xdc\x7b\xf4\xe9\x51\x34\x3c\xe3\xb9\xd1\xe3\xeb\xb6\xa7\xc8\x3e\x2c\x47\x2a\x2c\x86\x5e\x47\xbd\x80\xfe\x41\x9c\xff\xfc\x16\xf7\x03\xd8\x5f\x8e\xe7\x95\xfd\xff\xf4\xce\x82\x5e\x10\x4f\xd4\xa5\x0b\x35\x85\x24\x7a\x5d\x9d\xd0\xcb\x7f\x35\xbb\xb4\x78\x52\xea\xdf\x00\x00\x00\xff\xff\xf2\x48\xd3\x87\xe7\x04\x00\x00")
+var _assets_reviews_css = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x95\x54\x4d\x6f\xda\x40\x10\xbd\xef\xaf\x18\xa5\xaa\xd4\x46\xd8\x90\xa8\xea\x01\x4e\xe4\xa3\xad\xd5\x08\xa4\x40\x1a\xe5\xb8\xb6\x07\x33\x92\xbd\xeb\xee\x0e\x31\x34\xea\x7f\xef\xac\x81\xc4\x24\x69\xa3\x72\x31\xeb\x99\x79\xf3\xde\x9b\x59\xf7\x8f\xd5\xb9\xad\x37\x8e\x8a\x25\xc3\xe9\xe0\xe4\x33\x7c\xb5\xb6\x28\x11\x12\x93\xc5\x30\x2e\x4b\x68\x43\x1e\x1c\x7a\x74\xf7\x98\xc7\x4a\x5d\x51\x86\xc6\x63\x0e\x2b\x93\xa3\x03\x5e\x22\x8c\x6b\x9d\xc9\x63\x17\xe9\xc1\x0f\x74\x9e\xac\x81\xd3\x78\x00\x1f\x42\xc2\xd1\x2e\x74\xf4\x71\xa4\x36\x76\x05\x95\xde\x80\xb1\x0c\x2b\x8f\x02\x40\x1e\x16\x24\x4d\x71\x9d\x61\xcd\x40\x06\x32\x5b\xd5\x25\x69\x93\x21\x34\xc4\xcb\xb6\xc9\x0e\x22\x56\x77\x3b\x00\x9b\xb2\x96\x5c\x2d\xd9\xb5\x9c\x16\xdd\x2c\xd0\xac\x14\xc8\x6f\xc9\x5c\x0f\xfb\xfd\xa6\x69\x62\xdd\xb2\x8c\xad\x2b\xfa\xe5\x36\xcb\xf7\xaf\x92\xf3\xcb\xc9\xec\x32\x12\xa6\x4a\xdd\x98\x12\x7d\xd0\xfa\x73\x45\x4e\x04\xa6\x1b\xd0\xb5\xf0\xc8\x74\x2a\xec\x4a\xdd\x80\x75\xa0\x0b\x87\x12\x63\x1b\x78\x36\x8e\x98\x4c\xd1\x03\x6f\x17\xdc\x68\x87\x2a\x27\xcf\x8e\xd2\x15\x1f\x18\xb4\x67\x25\x4a\xbb\x09\x62\x91\x36\x70\x34\x9e\x41\x32\x3b\x82\xb3\xf1\x2c\x99\xf5\xd4\x6d\x32\xff\x36\xbd\x99\xc3\xed\xf8\xfa\x7a\x3c\x99\x27\x97\x33\x98\x5e\xc3\xf9\x74\x72\x91\xcc\x93\xe9\x44\x4e\x5f\x60\x3c\xb9\x83\xef\xc9\xe4\xa2\x07\x28\xf6\x48\x13\x5c\xd7\x2e\x70\x17\x82\x14\xac\x0b\x93\x9a\x21\x1e\x34\x5f\xd8\x2d\x19\x5f\x63\x46\x0b\xca\x44\x91\x29\x56\xba\x40\x28\xec\x3d\x3a\x23\x42\xa0\x46\x57\x91\x0f\xc3\xf3\x42\x2d\x57\x25\x55\xc4\x9a\xdb\xf3\x0b\x39\xb1\x3a\xee\x2b\x95\xda\x7c\x03\x0f\x62\x76\xa5\x5d\x41\x66\x08\x83\x7a\x3d\x52\xbf\x95\xaa\xb5\xa0\x45\xc4\x58\xb5\xe1\x86\x72\x5e\x0e\xc5\x35\xe1\x4b\x3c\x92\x37\xb5\xce\x73\x69\x1a\xa5\x96\xd9\x56\x43\x38\x39\xac\x2c\xc5\xa9\xd4\xae\xdb\xe2\x92\x0c\x46\x4b\x0c\xcb\x18\x20\xc4\x75\x5d\x3e\x6b\x12\x3f\xfd\x8d\x06\x5b\x42\x64\x3a\x35\x8f\x6d\xff\x17\x2b\x26\x67\x4d\xe4\xb1\xc4\x2c\x0c\x2d\x20\x2f\xac\xe1\xa8\xd9\x41\x18\xeb\xaa\x03\x84\x4c\xbb\xbc\x2b\xf9\x64\x30\x78\x3f\xda\x57\x79\xfa\x85\x43\xa8\x30\xa7\x55\xd5\xa9\x61\x6b\xcb\x54\xbb\xf8\xe0\xb4\xd3\xf1\xd2\xb9\xd7\xd8\xc7\xfb\xa2\xf0\xf4\x87\x40\x0f\x4f\x6e\xcb\x7c\xde\x4c\x87\x98\x89\x65\xe5\x1f\xfe\x4a\xfa\x1d\xdb\xfa\xec\x39\xdd\x37\xc8\x7e\xda\x8f\x97\xdb\xfb\xf4\x8a\x41\x8f\x14\x3b\xd5\xa9\x75\xb2\x76\x91\x97\xcb\xdb\x61\xcf\xee\xdf\x3b\xd5\x79\x17\xb4\xfa\x4c\x9b\xa8\x76\xb6\x68\x2f\xc9\x81\x1b\x61\xe9\xe4\xd3\x17\xa8\xed\xc5\xf2\xa6\x14\xb5\xb2\xf7\x72\xf5\xb7\xf5\x0e\xef\x09\x9b\x48\xbe\x52\x8c\xae\x27\x78\xd6\x71\xd4\x12\x7b\x13\xac\x75\xce\xcb\x82\xb4\x53\xfa\x03\x4a\x76\x87\xc1\x71\x05\x00\x00")
 
 func assets_reviews_css() ([]byte, error) {
 	return bindata_read(
@@ -106,7 +106,7 @@ func assets_reviews_css() ([]byte, error) {
 	)
 }
 
-var _assets_reviews_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\xdf\x6f\xdb\x36\x10\x7e\xd7\x5f\x71\xd5\x4b\x52\xc0\x92\x52\x0f\xe8\x80\x54\x36\xe0\x25\x59\x67\xac\x88\x8b\x38\x5d\x90\x47\x8a\x3c\x4b\x97\x51\x24\x47\x52\x56\x8c\xc0\xff\xfb\x40\x59\x4e\xac\xcc\x29\xd0\x01\x9b\x5f\x6c\xde\x1d\xef\xfb\xf8\xdd\x0f\xe7\xef\x2e\x17\x17\xb7\xf7\x5f\xaf\xa0\xf2\xb5\x9c\x46\xf9\xbb\x24\x89\x2e\xb4\xd9\x58\x2a\x2b\x0f\xe3\xb3\x0f\x1f\xe1\xb3\xd6\xa5\x44\x98\x2b\x9e\xc2\x4c\x4a\xe8\x5c\x0e\x2c\x3a\xb4\x6b\x14\x69\x14\x7d\x21\x8e\xca\xa1\x80\x46\x09\xb4\xe0\x2b\x84\x99\x61\xbc\x42\xe8\x3d\x23\xf8\x03\xad\x23\xad\x60\x9c\x9e\xc1\x69\x08\x88\x7b\x57\xfc\xfe\x53\xb4\xd1\x0d\xd4\x6c\x03\x4a\x7b\x68\x1c\x82\xaf\xc8\xc1\x8a\x24\x02\x3e\x72\x34\x1e\x48\x01\xd7\xb5\x91\xc4\x14\x47\x68\xc9\x57\x1d\x48\x9f\x22\x8d\xee\xfb\x04\xba\xf0\x8c\x14\x30\xe0\xda\x6c\x40\xaf\x0e\xa3\x80\xf9\x28\x02\x00\xa8\xbc\x37\xe7\x59\xd6\xb6\x6d\xca\x3a\x96\xa9\xb6\x65\x26\x77\x51\x2e\xfb\x32\xbf\xb8\xba\x5e\x5e\x25\xe3\xf4\x2c\x8a\xbe\x29\x89\x2e\xbc\xf5\xaf\x86\x2c\x0a\x28\x36\xc0\x8c\x91\xc4\x59\x21\x11\x24\x6b\x41\x5b\x60\xa5\x45\x14\xe0\x75\xe0\xd9\x5a\xf2\xa4\xca\x11\x38\xbd\xf2\x2d\xb3\x18\x09\x72\xde\x52\xd1\xf8\x81\x40\x7b\x56\xe4\xe0\x30\x40\x2b\x60\x0a\xe2\xd9\x12\xe6\xcb\x18\x7e\x99\x2d\xe7\xcb\x51\x74\x37\xbf\xfd\x6d\xf1\xed\x16\xee\x66\x37\x37\xb3\xeb\xdb\xf9\xd5\x12\x16\x37\x70\xb1\xb8\xbe\x9c\xdf\xce\x17\xd7\x4b\x58\xfc\x0a\xb3\xeb\x7b\xf8\x7d\x7e\x7d\x39\x02\x24\x5f\xa1\x05\x7c\x34\x36\x70\xd7\x16\x28\x48\x17\x2a\xb5\x44\x1c\x80\xaf\xf4\x8e\x8c\x33\xc8\x69\x45\x1c\x24\x53\x65\xc3\x4a\x84\x52\xaf\xd1\x2a\x52\x25\x18\xb4\x35\xb9\x50\x3c\x07\x4c\x89\x48\x52\x4d\x9e\xf9\xee\xfc\x8f\xe7\xa4\x51\x92\x4c\xa3\xbc\x6f\xa6\x0a\x99\x98\x46\x00\xb9\x27\x2f\x71\x7a\x83\x6b\xc2\x16\xbe\x90\xf3\x79\xb6\x33\x05\x67\x8d\x9e\x81\x62\x35\x4e\xe2\xe0\x37\xda\xfa\x18\xb8\x56\x1e\x95\x9f\xc4\x2d\x09\x5f\x4d\x04\xae\x89\x63\xd2\x1d\x46\x40\x8a\x3c\x31\x99\x38\xce\x24\x4e\x3e\xc4\xd3\x50\xd8\xdc\x71\x4b\xc6\x83\xb3\x7c\x12\x87\x1a\xbb\xf3\x2c\xe3\x42\x3d\xb8\x94\x4b\xdd\x88\x95\x64\x16\x53\xae\xeb\x8c\x3d\xb0\xc7\x4c\x52\xe1\xb2\x16\x8b\xd0\x57\x5a\xa1\xf2\xee\xc1\x65\x67\xe9\xcf\xe9\x78\x3c\x34\x27\x92\x3c\xa6\x35\xa9\xf4\xc1\xc5\xd3\x3c\xdb\xc1\x4c\xdf\x42\x0c\xc9\xd3\xb2\x1b\x19\x66\xc8\xbd\x02\x0c\xf2\x4a\x66\x1f\x5c\xf6\x21\x1d\xa7\xe3\x8f\x7b\xc3\x91\xfc\x01\x40\x92\xfa\x13\x2c\xca\x49\x4c\xf5\x4e\x97\xca\xe2\xea\x05\xcc\xb2\x36\x2d\xc9\x57\x4d\xd1\x38\xb4\xbd\x66\x1d\xe4\xa5\x6e\x95\xd4\x4c\x64\x46\xcb\x4d\x8d\x36\xe1\x42\x75\x98\x3f\xa5\xe3\x40\x65\x6f\xdf\x7f\xa7\xa1\x64\xf1\xf4\xbf\x06\x65\x06\x6d\x22\xc9\xf9\x42\x3f\x0e\x4f\xff\x27\x01\xaf\xb5\x2c\x98\x1d\x9e\xf6\x04\xbe\xc7\x20\x73\xa1\xf3\x79\x66\xbb\x4e\xee\xa8\x1f\xe5\xed\xfc\x46\xa2\xab\x10\xdf\xb8\xe9\x52\xee\xdc\x91\xb6\x7d\x1d\x35\xec\x88\x3c\xdb\x0d\x54\x5e\x68\xb1\x01\x55\x26\xcc\x98\x49\x5c\x92\x9f\x19\x63\x19\x39\xbc\xc3\x62\xc7\x44\xe8\x3a\xa9\xb5\x68\x24\x02\x89\x49\xdc\xa7\xeb\xf8\x76\x01\x61\x24\xb1\x36\x92\x79\xdc\x1d\x01\xf2\x81\x18\xd3\xdc\x19\xa6\x80\x4b\xe6\xdc\x24\xee\x66\x35\x9e\x7e\x26\x9f\xec\xa1\xe0\x0e\x0b\xf8\x36\x87\x29\x3c\x3d\x19\xe6\xab\xed\x36\xcf\xc2\x95\x69\x3e\x54\xf5\x55\xfa\xbe\xd8\x7b\x2b\x40\x7e\x20\x25\x58\x34\x7a\x12\x3f\x3d\x85\xef\xed\x36\x86\x0e\x77\x12\x2f\x0c\x2a\xd8\x2d\x0f\x17\x43\xff\x98\x10\x67\x50\x09\x52\xe5\x76\x1b\x54\x3a\x48\xf4\x63\xe9\x2f\xa4\x0e\xff\x5d\xc7\x00\x5c\x53\xd4\xe4\x3d\x8a\x37\x21\xf2\xec\xc8\xc3\xf2\x6c\xa8\x6e\xfe\xb2\x33\xc2\x87\x09\x71\xb5\x46\xe5\xc3\x22\x44\x85\xf6\xf4\xe4\x0e\x8b\x8b\xe7\x85\x73\x83\x4c\x6c\x4e\x46\xb0\x6a\x14\x0f\x6b\xf6\xf4\x3d\x3c\x3d\x3f\xe8\xeb\xae\xb1\x4f\x5f\x2c\x00\xe4\xce\xe1\xe4\xb0\xc6\x27\xa3\x03\xaf\xb1\xda\xa0\xf5\x84\xee\x1c\x0e\x6f\x01\x84\xba\xbd\xb6\x01\xf8\x8d\xc1\x73\x58\x7a\x4b\xaa\x1c\xb8\xb6\xa3\xc1\x31\xa8\xf8\xef\x6f\xf7\xa5\x7b\x2b\xc1\xcc\x5a\xb6\xf9\xde\xfd\xe7\xca\xfc\x40\x86\xe8\xd8\xef\xed\xfb\x4f\xd1\xf0\xd7\x60\xc5\x67\x2f\xa3\xb4\x1b\x57\x41\xeb\x30\x7a\x61\xeb\x58\x2d\x25\xda\x49\x1c\x24\xdf\x77\x4f\x5f\xf0\xc3\x6a\x74\x3a\x77\xed\xda\xcd\x49\xfc\xba\x0f\x5f\xd1\x3f\x50\x27\x04\x69\x83\xaa\x4f\x7e\x3c\xf6\x59\x89\x10\xcd\xbb\x5e\x7e\x89\xdf\xf7\xe3\x21\x9f\xfe\x5d\xb4\x0e\x1b\x25\xac\x92\x6e\xb3\x74\xff\xd8\x7f\x07\x00\x00\xff\xff\x4e\x29\x7e\xa0\x0f\x0a\x00\x00")
+var _assets_reviews_html = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x56\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\xcc\xea\xe2\x04\xb0\xa5\xc4\x05\xb6\x40\x2a\x1b\x70\x93\x74\x6b\x74\x11\x07\x71\xd2\x20\x47\x4a\x1a\xdb\x4c\x69\x52\x25\x29\x3b\x46\x36\xff\xbd\x43\x4a\xb2\xad\xc4\x59\x64\x0b\xb4\xbe\x58\xe4\x0c\x67\xde\x7c\x3d\x32\xf9\x74\x31\x39\xbf\x7d\xb8\xbe\x84\x85\x5d\x8a\x61\x90\x7c\xea\xf5\x82\x73\x55\x6c\x34\x9f\x2f\x2c\xf4\x4f\x4e\x3f\xc3\x17\xa5\xe6\x02\x61\x2c\xb3\x08\x46\x42\x80\x17\x19\xd0\x68\x50\xaf\x30\x8f\x82\xe0\x2b\xcf\x50\x1a\xcc\xa1\x94\x39\x6a\xb0\x0b\x84\x51\xc1\x32\xfa\xab\x25\x5d\xf8\x13\xb5\xe1\x4a\x42\x3f\x3a\x81\x23\xa7\x10\xd6\xa2\xf0\xf8\x97\x60\xa3\x4a\x58\xb2\x0d\x48\x65\xa1\x34\x48\x06\xb8\x81\x19\x27\xa7\xf8\x94\x61\x61\x81\x4b\xc8\xd4\xb2\x10\x9c\xc9\x0c\x61\xcd\xed\xc2\x3b\xa9\x4d\x44\xc1\x43\x6d\x40\xa5\x96\x91\x2e\x23\xed\x82\x56\xb3\x7d\x2d\x60\x36\x08\x80\x7e\x0b\x6b\x8b\xb3\x38\x5e\xaf\xd7\x11\xf3\x28\x23\xa5\xe7\xb1\xa8\xb4\x4c\xfc\x75\x7c\x7e\x79\x35\xbd\xec\x11\xd2\x20\xb8\x93\x02\x8d\x8b\xf5\xef\x92\x6b\x0a\x30\xdd\x00\x2b\x08\x47\xc6\x52\x42\x27\xd8\x1a\x94\x06\x36\xd7\x48\x32\xab\x1c\xce\xb5\xe6\x96\xcb\x79\x17\x8c\x9a\xd9\x35\xd3\x18\xe4\xdc\x58\xcd\xd3\xd2\xb6\x12\xd4\xa0\xa2\x48\xf7\x15\x28\x45\x4c\x42\x38\x9a\xc2\x78\x1a\xc2\xaf\xa3\xe9\x78\xda\x0d\xee\xc7\xb7\xbf\x4f\xee\x6e\xe1\x7e\x74\x73\x33\xba\xba\x1d\x5f\x4e\x61\x72\x03\xe7\x93\xab\x8b\xf1\xed\x78\x72\x45\xab\xdf\x60\x74\xf5\x00\x7f\x8c\xaf\x2e\xba\x80\x94\x1e\x72\x82\x4f\x85\x76\xd8\x09\x20\x77\xa9\x73\x95\x9a\x22\xb6\x9c\xcf\x54\x05\xc6\x14\x98\xf1\x19\xcf\x28\x22\x39\x2f\xd9\x1c\x61\xae\x56\xa8\x25\x05\x02\x05\xea\x25\x37\xae\x78\x86\xa0\xe5\x81\xe0\x4b\x6e\x99\xf5\xeb\x37\xe1\x44\x41\xaf\x47\x5d\x54\x37\xd3\x02\x59\x3e\xa4\x9c\x27\x96\x5b\x81\xc3\x1b\x5c\x71\x5c\x93\xaa\xb1\x49\x5c\x6d\x39\xe1\x12\x2d\x03\xc9\x96\x38\x08\x9d\xbc\x50\xda\x86\x54\x40\x69\x51\xda\x41\xb8\xe6\xb9\x5d\x0c\x72\x3a\x9a\x61\xcf\x2f\xba\x94\x66\xca\x31\x13\x3d\x93\x31\x81\x83\xd3\x70\xe8\x0a\x9b\x98\x4c\x73\xea\x15\xa3\xb3\x41\xe8\x6a\x6c\xa8\xc8\x59\x2e\x1f\x4d\x94\x09\x55\xe6\x33\x41\xc5\x88\xa8\x8d\x62\xf6\xc8\x9e\xa8\xde\xa9\x89\xd7\x98\xba\xbe\x52\x92\x5c\x99\x47\x13\x9f\x44\x3f\x47\xfd\x7e\x7b\xbb\x27\xb8\xc5\x68\xc9\x65\xf4\x68\xc2\x61\x12\x57\x6e\x86\xef\x79\x74\xc6\xa3\xb9\x1f\x19\x56\x70\xf3\xca\xa1\x4b\x2f\xe1\x20\x57\xa7\x51\x3f\xea\x7f\x6e\x36\x0e\xd8\x77\x0e\x04\x97\x7f\x51\xf3\x89\x41\x48\x25\xf4\x79\x59\x68\x9c\xed\x9c\x69\xb6\x8e\xe6\x54\xee\x32\xa5\xb1\xd1\x75\xce\xbc\xcb\x0b\xb5\x96\x42\xb1\x3c\x2e\x94\xd8\x2c\x51\xf7\x28\x13\xde\xe7\x4f\x51\xdf\x41\x69\xf6\x9b\xff\xc8\x95\x2c\x1c\xfe\xd7\x4e\x19\x75\x13\x25\xd4\xd8\x54\x3d\xb5\x57\xff\x27\x00\xab\x94\x48\x99\x6e\xaf\x1a\x00\xdf\x43\xa0\x7d\x07\x7b\xc8\x07\xf1\x1a\xbb\x21\xbe\x58\x20\xbe\x3a\x41\x6d\x60\xcc\x81\x36\x6d\xa4\xed\xca\x27\x71\x35\x38\x49\xaa\x72\x22\xc5\x79\x8f\x28\x67\x10\x52\xc4\xa3\xa2\xd0\x8c\x1b\xbc\xc7\xb4\xf2\x9c\xab\x65\x6f\xa9\xf2\x92\xb8\x88\xe7\x5b\x73\x1e\x9f\x57\x70\xa3\x87\x34\xfc\xcc\x62\xb5\xa4\x8d\x56\xd0\xc3\xc4\x14\xc4\x37\x99\x60\xc6\x0c\x42\x3f\x93\xe1\xf0\x0b\xb7\xbd\xc6\x15\x90\x2f\xb8\x1b\xc3\x10\x9e\x9f\x0b\x66\x17\x2f\x2f\x84\x93\x8e\x10\xdc\xb6\xa1\xb6\xf9\xba\xa8\xcd\x2e\xed\xef\xa5\x8e\x72\x55\xa8\x41\xf8\xfc\xec\xfe\x5f\x5e\x42\xf0\x7e\x07\xe1\xa4\x40\x09\x15\x49\x98\x10\xea\x60\x9c\x1e\xed\xe7\x44\x45\xa4\x4a\x6e\xf7\x0c\xfd\x98\xf9\x73\xa1\xdc\x1d\x75\xc8\x81\x29\x53\x22\x35\xe2\xdf\x77\x5d\x24\xf1\x81\xc0\x88\xc5\x5a\xd9\x4d\x76\xdc\xe0\x7e\x2c\xcf\x2f\x57\xd4\x9a\x8e\xf0\x50\xa2\x3e\xea\x50\x32\xcf\xb7\xc4\x72\x43\x45\xde\x74\xba\x30\x2b\x65\xe6\xe8\xf4\xe8\x18\x9e\xb7\x01\x5d\x57\x0d\x7c\xb4\xdb\x01\xba\x2a\xce\xa0\xb3\x5f\xe3\x4e\x77\x4f\x5a\x68\x45\x00\x2d\x47\xd2\xda\x3f\x45\x12\xaa\xdb\xeb\x3d\x00\xbb\x29\xf0\x0c\xa6\x74\xf3\xc8\x79\x4b\xf4\xd2\x6d\x2d\x5d\x16\xff\xfd\xe9\xba\x74\xef\x19\x18\x69\xcd\x36\xdf\x3b\xbf\xad\xcc\x0f\x58\x08\x0e\x7d\xbf\xd0\x3b\xa3\xfd\xd5\xa2\xf2\x78\x37\x4a\xd5\x98\xe6\x7c\xe5\x46\xcf\xb1\x8b\x56\x42\xa0\x1e\x84\x2e\xe5\x4d\xf7\xd4\x05\xa7\x0b\x74\xd9\x4c\x4f\xdd\x35\xf4\x6e\xb1\xa8\x43\x77\xb8\x02\x4f\xb4\x80\x4c\x67\x8b\xa3\xe3\x70\xdb\x4d\x5c\x16\xa5\xf5\x11\xd0\xd4\xe1\x13\xd1\x05\xb5\x51\x86\x0b\x25\x72\xe7\xe9\x02\x2b\x68\xd4\x15\xde\x10\x01\x73\xf4\x52\x99\x8e\xf2\x3d\xe9\x47\x2d\xde\xd0\x0b\x06\x4d\x03\xac\x6d\x4f\x6f\x65\x1f\xb7\xe6\x42\x7d\xc7\x58\x2d\xfa\xa8\xad\x6b\xea\x4e\x6a\x5e\x9c\xf1\xa7\x03\xe6\x5c\xef\xee\x4c\x19\x14\x98\xd9\xb7\x5a\x86\x1e\x24\xe8\x4f\x2b\x9f\x16\xe3\xa8\x98\xb6\x80\x19\x38\xaa\xbe\xbe\x7d\x83\x0e\x93\x1b\xf0\xab\xce\xb1\x7f\xfa\x54\x12\x7a\xb4\xf9\x8f\x8a\x83\xbd\x87\xad\x43\x7a\x94\x59\x7a\x92\x55\xe0\xab\x72\x86\xc3\xa9\x2f\x67\x12\x57\xc2\x46\x77\x4a\xf7\x04\xbd\x0f\xdf\x85\x6a\xfc\x3d\xb2\x0f\x51\x69\x4a\x40\xb4\x62\xa2\xf4\x40\xab\xa5\x60\x29\x0a\x8f\xce\xaf\x3d\x3a\x3a\x39\x71\x8b\x37\x08\x93\xd8\x35\x60\xfd\xbd\xcf\x0c\x7e\xe6\x3d\x75\x7a\xce\x0e\x5f\x73\xe2\xab\x51\xda\x9b\x54\xa7\x44\x44\x22\xeb\x46\x3f\xac\xbb\x9d\x4a\xa7\x9d\x79\x5e\xdd\xe9\x37\xd0\xf6\xf1\xd4\x33\xc6\x57\xee\x76\x73\xd7\x9a\xbf\xe5\xfc\x2b\xf1\x1f\x44\xa1\xe0\x86\x83\x0c\x00\x00")
 
 func assets_reviews_html() ([]byte, error) {
 	return bindata_read(
@@ -115,7 +115,7 @@ func assets_reviews_html() ([]byte, error) {
 	)
 }
 
-var _assets_reviews_js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x7f\x6f\xdb\xb8\x19\xfe\x5f\x9f\xe2\x9d\x51\x34\xf2\x45\x27\xa7\x05\x76\x38\xcc\x73\x87\xac\xd7\xdd\x8c\x65\x49\x17\xe7\x56\x1c\xbc\xa0\x60\xa4\xd7\x12\x71\x34\xa9\x23\xe9\xa6\x41\x91\xef\x3e\xf0\x87\x24\x52\x52\x52\xef\x70\xc0\xf2\x57\x4c\x3e\xef\xc3\xf7\xe7\x43\xda\x8b\x6f\x92\xb7\xa2\x79\x90\xb4\xaa\x35\xbc\x3e\x7b\xf5\x1d\xfc\x28\x44\xc5\x10\xd6\xbc\xc8\xe1\x9c\x31\xb0\x5b\x0a\x24\x2a\x94\x9f\xb0\xcc\x93\xe4\x82\x16\xc8\x15\x96\x70\xe0\x25\x4a\xd0\x35\xc2\x79\x43\x8a\x1a\xc1\xef\x64\xf0\x6f\x94\x8a\x0a\x0e\xaf\xf3\x33\x48\x0d\x60\xe6\xb7\x66\xf3\x65\xf2\x20\x0e\xb0\x27\x0f\xc0\x85\x86\x83\x42\xd0\x35\x55\xb0\xa3\x0c\x01\x3f\x17\xd8\x68\xa0\x1c\x0a\xb1\x6f\x18\x25\xbc\x40\xb8\xa7\xba\xb6\x87\x78\x8a\x3c\xf9\xd9\x13\x88\x3b\x4d\x28\x07\x02\x85\x68\x1e\x40\xec\x42\x14\x10\x9d\x24\x00\x00\xb5\xd6\xcd\x9f\x16\x8b\xfb\xfb\xfb\x9c\x58\x2f\x73\x21\xab\x05\x73\x28\xb5\xb8\x58\xbf\x7d\x77\xb9\x79\xf7\xed\xeb\xfc\x2c\x49\x7e\xe2\x0c\x95\x89\xf5\xd7\x03\x95\x58\xc2\xdd\x03\x90\xa6\x61\xb4\x20\x77\x0c\x81\x91\x7b\x10\x12\x48\x25\x11\x4b\xd0\xc2\xf8\x79\x2f\xa9\xa6\xbc\xca\x40\x89\x9d\xbe\x27\x12\x93\x92\x2a\x2d\xe9\xdd\x41\x47\x09\x6a\xbd\xa2\x0a\x42\x80\xe0\x40\x38\xcc\xce\x37\xb0\xde\xcc\xe0\xaf\xe7\x9b\xf5\x26\x4b\x3e\xac\x6f\xfe\x7e\xf5\xd3\x0d\x7c\x38\xbf\xbe\x3e\xbf\xbc\x59\xbf\xdb\xc0\xd5\x35\xbc\xbd\xba\xfc\x61\x7d\xb3\xbe\xba\xdc\xc0\xd5\xdf\xe0\xfc\xf2\x67\xf8\xc7\xfa\xf2\x87\x0c\x90\xea\x1a\x25\xe0\xe7\x46\x1a\xdf\x85\x04\x6a\x52\x67\x2a\xb5\x41\x8c\x0e\xdf\x09\xe7\x8c\x6a\xb0\xa0\x3b\x5a\x00\x23\xbc\x3a\x90\x0a\xa1\x12\x9f\x50\x72\xca\x2b\x68\x50\xee\xa9\x32\xc5\x53\x40\x78\x99\x30\xba\xa7\x9a\x68\xfb\x79\x14\x4e\x9e\x7c\xb3\x48\x92\x4f\x44\x42\x45\xf5\x79\xd3\x48\x42\x15\x7e\xc0\xbb\x95\xe1\x65\x44\xe6\x7b\x51\x1e\x18\xa6\xb3\x78\x7b\x96\xc1\xf6\x76\xbe\x4c\x92\xc5\x02\x7e\x44\x0d\x04\x24\x36\x42\x51\x2d\xe4\x03\x70\xb2\x47\xd8\x49\xb1\xb7\x07\xed\x0e\x8c\x41\x43\x74\x9d\x27\xbb\x03\x2f\x8c\x1f\x50\xa1\xbe\x20\x4a\xbf\x27\xba\x7e\xc7\x70\x8f\x5c\xa7\x06\x31\x87\x2f\x09\x80\x71\x46\x31\xa2\xea\x35\x2f\xf1\x33\xac\x9c\x31\x23\x4a\xdb\x85\xab\x5d\x3a\x5b\x98\x26\x04\xa0\x3b\x48\x03\xe4\x1b\x38\x73\x0c\x00\x12\xf5\x41\x72\x67\xa9\x0e\x77\xa6\x5c\xbc\x0a\xb0\xa7\xaf\x32\x4f\x8b\xbc\xd2\xb5\x65\x7b\x4c\x22\xbb\x65\xf2\x18\x85\xf7\x89\xe2\x3d\xa8\xc3\x7e\x4f\xe4\xc3\x20\xba\x12\x55\x21\x69\x63\x42\x8b\x83\xdc\x38\x78\x6a\x00\x7d\x70\x12\xd5\x81\x69\x58\x59\xbb\xa5\x5f\xe4\x78\xcf\x28\xc7\x36\x66\xb3\x95\xd3\x36\xde\xff\xf0\x3e\xe0\x08\x18\x85\x1c\xb0\x06\x41\x9f\x65\x11\x77\x17\xaa\xe1\x72\x36\x3e\x09\xf0\x06\xbe\x1f\xb3\x79\x48\xc4\xf7\xfd\xd9\x30\x61\x0e\x65\x53\x16\x37\x4a\x5e\x08\xae\xa5\x60\x0c\x65\x3a\x63\x54\xe9\x6b\xd3\x27\xb3\x0c\xda\x34\xa5\x2f\x54\x21\x1a\xcc\x5e\x98\x29\x77\xa7\xdb\x7f\xf3\x0a\x75\x3a\x5b\x90\x86\x2e\x6c\x6b\xcd\xe6\xb9\x3a\x14\x05\x2a\x95\x5a\x07\x3b\x7b\x89\xaa\x11\x5c\xe1\x1c\xbe\x38\xaa\xbc\x6b\x45\x8a\xca\xb4\x8f\x14\xc6\xec\xa2\x3d\xfc\xda\x1b\xf4\x96\xcb\x47\xd3\xca\x3d\xe7\x11\x26\x3e\x4d\xae\x9c\x8d\x30\xe7\x6c\x6f\x97\xce\x33\x21\x21\x35\x1b\xd4\xc8\xcb\xd0\xc2\xd9\x98\x06\x73\xc9\xb5\x9b\x5b\x7a\x9b\xbb\x9e\x73\x10\x4b\x99\x37\x07\x55\x9b\x72\x83\xf1\x21\x0d\xb1\xb4\xcc\x9e\x9c\xa1\xf9\xdc\xb1\x3c\x86\x83\x60\x09\x5d\xcd\xc2\x38\x2d\xb1\x21\x33\x23\xdb\x7a\x68\xc4\x3c\xa7\x25\xac\x80\x96\xcb\x7e\xc5\x4e\xf5\xca\x22\x1d\x91\x4d\xda\x57\xab\x6d\xc6\xe6\xc9\x7a\x67\x2f\x98\x28\xac\x34\x85\xd3\xd1\x08\x58\x41\xb7\x93\x2b\x24\xb2\xa8\xd3\xf9\xf6\xc4\x6c\x9d\xd8\x2c\x07\x95\xb6\x69\x6c\xc4\xf2\x89\xc6\xf9\xe8\x47\xf6\x2f\xe6\xc3\x6a\x06\xa7\x16\x7d\x7c\x33\xf9\x4a\x4d\x64\xbb\xc5\x5a\x88\xeb\x21\x00\x13\xf4\x39\x63\x3e\x6e\xef\x87\x68\x90\x7f\x74\x0a\xa2\x62\x3f\xb2\x89\xa3\x5d\x15\xfc\xf1\xc6\xd4\x93\x05\xfd\x62\x0b\xf0\xf4\x79\x05\x13\x0a\xcb\xdf\x78\xa2\x33\x7e\xfa\xcc\xb0\x7f\x48\x59\xbe\x27\x15\xae\x35\xee\x55\xda\x90\x0a\x33\x2f\x94\xaa\x6d\xa6\x78\x18\x0c\x24\xa7\x06\x1d\x8f\x83\x31\xb2\xaf\x8d\x55\x00\x31\x8d\xde\x6e\x2c\x03\xb0\xa6\x7b\x54\x9a\xec\x9b\x09\xf4\xaf\x07\x54\x3a\xef\x10\xa1\x99\x91\xc5\x27\x2d\x02\x05\xef\x67\xd0\x06\x12\x4e\xa1\x59\x48\x5b\x97\xb2\xde\x91\xcc\x92\x67\x23\xc5\x0f\x47\x31\x1e\xbc\x60\x36\x5a\x6d\x79\xef\x34\x47\xc8\xf4\x8e\x28\xfc\xd7\x01\xe5\x43\x06\x05\x61\xec\x8e\x14\xbf\xc4\x7a\xd3\x96\xa6\x53\x9c\x81\x6e\x09\x39\x12\x2a\x88\x6b\xd5\x6e\xf7\xf5\x6a\xc3\xf6\xd7\x82\xeb\x6c\x8e\x9f\xb5\x31\xba\x11\xbf\x20\xef\xa9\xc2\x49\xeb\x9c\x85\x53\x98\xbd\x34\xd9\xf5\xad\x36\x49\xd1\x8d\x5d\xe7\x69\x77\xf0\x23\x20\x53\x18\x9c\xd1\xc6\x9e\x0e\x5d\x7c\x1c\xeb\x5b\x47\x37\xd6\xb8\xc1\x84\x3c\x93\xdc\x89\xa0\x7a\x87\xff\xe7\x8a\xcd\xa7\xe4\xf6\x6b\x1d\xe4\xc5\x2a\x92\xe2\x60\x38\xe2\x71\xb0\xbb\xe1\x34\x0c\xfa\xde\xee\xfb\xb6\x6f\x9f\x1a\x7e\xb5\x7d\xc7\xac\xda\x13\x8f\x93\xf4\x0a\x7d\x0e\x7e\x77\x41\xef\x1b\xfb\x49\xa8\xd9\xf4\xea\xff\xff\xd7\xf9\xb1\x0b\xc6\xbd\x8f\x25\x6a\x42\xd9\x40\x72\xed\x64\x38\x80\x5f\x33\xff\x1e\xe1\x9a\xef\x77\xef\xa1\xe7\x1e\x48\xb2\xf9\x63\x82\x94\x1b\x4e\x9b\x06\x75\x3f\xda\x79\x21\xf6\xc6\xff\x76\x6e\x9e\xf7\x9b\xee\x76\xbf\x93\xd3\xad\xb7\x74\xb7\x0b\x5d\x1d\x5e\x1d\x91\xcf\xde\xd5\x9b\x5a\x22\x29\x55\x28\x76\x7e\xe7\xc2\x37\x84\x89\xfe\xcb\xe3\xd4\x3b\x6b\x9a\x22\x22\x71\x7b\xb0\x1a\x60\xb7\xf4\x36\x14\xbf\x93\xb6\xf9\x4e\x46\xb4\x6d\x4a\x43\x25\x34\xfc\xad\xc5\x90\xba\xc5\xe7\x2d\x60\xd9\x99\x99\x93\xd2\x13\xb3\x4f\xb5\x3d\xa8\x9f\x9b\x97\x2f\x21\x3d\x31\xbd\x16\xaf\x87\x87\xf6\x61\x51\xf3\x46\xef\xc6\xc5\xad\x2c\x07\x38\xdf\xda\x1d\x2a\x7c\x69\xb6\xbe\xfc\x21\xf5\x6c\x7d\xcc\x5d\xce\x07\x47\xc3\x08\xb0\x75\xb6\xb7\x41\x71\x42\xa5\x1e\x7a\x6c\x86\x4b\xf5\xb9\x1a\xd1\x8c\x7d\xb3\x11\x78\xcf\xbc\xfd\xa4\x53\x7e\x6f\x6b\xf0\x5f\xf7\xc6\xa0\x2e\x28\xc7\xd6\x97\xc8\x7a\xe8\xc4\x89\x24\xbc\xc2\x41\xa9\x62\x0f\xac\x86\x19\x54\x98\x6c\xbb\xb0\x8c\x70\x96\x4e\x69\x22\xb5\x39\xdd\x52\x5a\xd4\x90\xcf\x37\x17\xe5\x86\xd0\x22\xf2\xce\x6a\x39\x40\xba\x3c\x59\x2c\xe5\x7d\x64\xa3\x2c\x99\xbf\x6e\x77\x6b\xf0\xb7\xc1\x43\x62\x3a\x57\xa1\x27\x7e\x66\xfc\xb7\xf2\x80\x65\x48\x10\x80\xdd\x13\x2a\x9e\x8c\x9a\xa8\x7a\x1e\xdb\x3c\x4e\x96\xea\x71\x70\xe9\xc7\xa3\xff\x4c\xd3\x8e\x14\xe0\xf8\xc6\xeb\xe8\xa7\xfa\x6e\x30\xfb\x47\x36\x91\xbb\xdf\x48\x89\xd2\xdc\xb9\x4e\xf7\xae\xed\x67\xdb\xdc\x99\xb7\xcd\x7a\xbe\x6c\x28\x69\xcb\x89\xd7\x57\x70\xff\x99\x7b\xda\xc8\xfd\x84\x8c\x3b\x6e\xbb\xe6\x13\x66\x56\x77\x94\xb9\xb7\x9a\xbd\xd7\x3a\x61\x77\x5e\x4e\x3c\xb6\xe2\xb7\xcc\x6f\x8b\x21\xfe\x79\xe6\x99\x3b\xcf\x15\xcd\x46\xd4\x66\xb7\xbb\xd8\x54\xc3\xa8\xee\x7f\x14\x89\x2a\x36\x9e\x80\xa0\x5e\x66\x48\x2c\xe0\x0d\x9c\x19\x99\xb5\xff\xff\x79\x15\x1d\xd4\xfe\x22\x34\x52\x5b\x3b\x7a\x94\x57\x17\x6e\x1e\xff\x49\x74\x9d\xef\xc9\xe7\xf4\x2c\x73\x3c\xdf\xc2\x1f\xe7\x43\xe5\x45\x5e\x3e\x6b\xf1\x6a\x64\xe1\xd3\xda\xc6\x1c\x0f\x66\x70\xe3\xad\x22\x7f\x96\x40\x4d\x1c\xfd\x71\x4b\xa0\xa7\xa7\xc3\xd9\x0f\xb9\xfb\xaf\x35\x9b\x7e\x35\xa5\xa7\xaf\xb2\x28\x1b\x5b\x7a\x3b\x9f\x3f\xa7\xa1\x9e\x13\x56\x10\x70\xa5\x61\x23\x64\xd1\xb9\xf3\xc9\x78\xea\xa8\x64\x4e\x50\xa6\xd4\xd5\xa8\xc6\x58\x7a\xb6\xf5\x40\x7d\x8e\x7b\x19\x84\xc4\x47\xbf\x10\xc2\x56\x1a\x2b\x1a\xac\x56\xd6\xc7\x29\xd5\x8d\xd1\x7d\xde\xfc\x7f\xcf\x0b\xf0\x51\xda\x38\xfe\xc2\x11\xd6\xd6\xa4\xea\xf2\xb0\xbf\x43\xd9\x95\x58\x45\xdf\x33\x7a\x80\xb9\xbf\xba\x0f\xc1\xb7\x86\xd6\x0c\xba\x91\x99\xf8\x4d\x69\xba\x07\x58\x38\x8b\x9e\xcc\xbf\x5e\xc2\x47\x8b\xdd\xd9\x49\x8a\xbc\x64\x0f\x6f\x63\x44\xf4\x1b\xe4\x77\xf3\xc0\xc0\x3f\x70\xfa\x77\x4d\x17\x8f\xf2\xa1\xa8\xfe\xfb\xcd\x7f\x03\x00\x00\xff\xff\x14\x9b\x4a\x6c\x30\x19\x00\x00")
+var _assets_reviews_js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x5a\xdd\x73\xdb\x36\x12\x7f\xd7\x5f\x81\x70\x32\x31\x95\x30\xb4\x9d\xb9\x7e\x5c\x54\x25\xa7\x26\xb9\x9e\xe6\x7c\x76\x4e\x76\x2e\xd3\x71\x3d\x19\x88\x82\x24\x34\x14\xc9\x00\xa4\x65\x4f\xaa\xff\xfd\x76\xf1\x41\x02\x24\xe5\x38\x6d\x1f\xee\xe1\xfa\x50\x8b\xc0\x7e\x63\xf7\xb7\x0b\x32\x87\x8f\x07\xaf\xf2\xe2\x56\xf0\xd5\xba\x24\xcf\x8e\x8e\xbf\x25\x3f\xe5\xf9\x2a\x65\x64\x9a\x25\x31\x99\xa4\x29\x51\x5b\x92\x08\x26\x99\xb8\x66\x8b\x78\x30\x38\xe1\x09\xcb\x24\x5b\x90\x2a\x5b\x30\x41\xca\x35\x23\x93\x82\x26\xf0\xc7\xec\x44\xe4\x3f\x4c\x48\x9e\x67\xe4\x59\x7c\x44\x42\x24\x08\xcc\x56\x30\x1c\x0d\x6e\xf3\x8a\x6c\xe8\x2d\xc9\xf2\x92\x54\x92\x81\x00\x2e\xc9\x92\x83\x52\x76\x93\xb0\xa2\x24\x3c\x23\x49\xbe\x29\x52\x4e\xb3\x84\x91\x2d\x2f\xd7\x4a\x89\x11\x11\x0f\x7e\x36\x02\xf2\x79\x49\x81\x96\x02\x75\x01\x4f\x4b\x97\x8a\xd0\x72\x30\x20\xf0\xdf\xba\x2c\x8b\xe7\x87\x87\xdb\xed\x36\xa6\xca\xca\x38\x17\xab\xc3\x54\x53\xc9\xc3\x93\xe9\xab\x37\xa7\xe7\x6f\x9e\x82\xa5\x83\xc1\xbb\x2c\x65\x12\x7d\xfd\x54\x71\x01\x0e\xce\x6f\x09\x2d\xc0\x8e\x84\xce\xc1\xba\x94\x6e\x49\x2e\x08\x5d\x09\x06\x7b\x65\x8e\x76\x6e\x05\x2f\x79\xb6\x8a\x88\xcc\x97\xe5\x96\x0a\x36\x58\x70\x59\x0a\x3e\xaf\x4a\x2f\x40\xd6\x2a\xf0\xd4\x25\x80\x10\xd1\x8c\x04\x93\x73\x32\x3d\x0f\xc8\x8f\x93\xf3\xe9\x79\x34\x78\x3f\xbd\xf8\xc7\xd9\xbb\x0b\xf2\x7e\x32\x9b\x4d\x4e\x2f\xa6\x6f\xce\xc9\xd9\x8c\xbc\x3a\x3b\x7d\x3d\xbd\x98\x9e\x9d\xc2\xd3\xdf\xc9\xe4\xf4\x67\xf2\xcf\xe9\xe9\xeb\x88\x30\x08\x0f\x28\x61\x37\x85\x40\xdb\xc1\x40\x8e\xa1\xc3\x93\x3a\x67\xcc\x53\xbe\xcc\xb5\x31\xb2\x60\x09\x5f\xf2\x04\x3c\xca\x56\x15\x5d\x31\xb2\xca\xaf\x99\xc8\xc0\x11\x52\x30\xb1\xe1\x12\x0f\x4f\x82\x69\x8b\x41\xca\x37\xbc\xa4\xa5\x7a\xee\xb8\x13\x0f\x1e\x1f\x0e\x06\xd7\x54\x90\x15\x2f\x27\x45\x21\x28\x97\xec\x3d\x9b\x8f\x51\x6e\x4a\x45\xbc\xc9\x17\x55\xca\xc2\xc0\xdf\x0e\x22\x72\x79\x05\x89\x30\x38\x3c\x24\x93\xb7\x53\xf2\x6e\x76\x02\xca\x04\x83\xc0\xa7\xa0\xea\x9a\x61\x70\x95\xa1\xa8\x39\x21\x05\x98\x28\x31\xc4\xb0\x48\x4b\xb5\xf3\x6e\x4a\x3e\x32\x56\x48\xb2\xcd\xc5\x47\xb0\x1b\x45\x6d\xd7\x2c\xd3\x6c\x98\xa9\x02\x63\xbd\xc9\xab\xac\x39\x08\x0a\x92\x20\x97\x20\x50\x4b\x7e\x13\x2b\xbb\x69\xc1\x67\x39\xe4\xe1\x98\x04\x71\x7c\x08\x4f\x87\x81\xb6\xeb\x02\xe4\x6c\xe8\x0d\xdf\x54\x1b\x92\x55\x9b\x39\xb0\x63\x82\xf1\x0d\x93\xda\x0a\x38\x37\xb4\x1d\x73\x85\xc9\x12\x95\x09\x06\xe7\x0a\xca\xe8\xb2\x34\x61\xd2\x86\xa0\x38\x41\x4b\xf6\x54\xc5\x52\x12\x5e\x6a\xdd\x20\x7e\x06\xcb\x27\xb8\x3a\x53\xbc\x12\xec\xf8\x46\xeb\xc7\x85\x5b\x57\x83\x6c\x9c\x37\xfe\x35\x32\xd9\x22\x82\x54\x4a\x98\xbb\x2b\xe9\xad\x66\x41\x69\xb0\x7e\xab\x4a\x66\x8e\xfb\x19\x58\xbf\x82\xca\x89\xc9\x09\x15\x2b\x0c\xfb\x35\x67\x5b\x74\x80\x82\xf5\xba\x14\x31\x57\x18\xc8\xb9\xc5\x52\xdc\x00\x4b\x04\xf1\xe5\xc9\x1a\xa5\x25\xe0\xfb\x1c\xcc\x5d\xa6\xb7\xaa\x64\xb1\x1a\x6a\xc5\x07\x52\x19\x46\x94\x61\xf1\xc0\x3f\xf9\x38\xc9\xb3\x25\x5f\x85\xcb\x2a\x4b\x30\xa7\xc2\x87\x58\x9e\x6f\x45\x7e\xcd\xe1\x80\x86\xe4\x33\x94\xac\xb7\x14\x73\x38\x3f\x81\xa8\x90\x0b\x19\x17\x95\x5c\x3b\xbc\x9f\x22\xf2\x90\x67\xbf\xb2\x04\x36\xe1\x27\x1e\x4e\x5e\x95\x5a\x0a\xc1\xe3\xa8\x44\x66\x1e\xf0\x51\x16\x90\xc4\xec\x8d\x10\xb9\x78\x4e\x6a\x29\x82\xa1\x00\xf8\x35\xac\x49\x09\xc1\xe3\xd1\xa6\xc2\x89\xd4\x14\xc6\xfa\x51\x4d\xc6\x97\xa4\xe1\x8f\x31\x5b\x2b\x49\x1e\x8c\xc9\x5f\x9e\xfd\x95\xfc\xf6\x1b\x09\x35\x7d\x2c\xda\xa7\x0c\x7b\x47\x43\xf2\x62\xdc\x97\x02\xae\x19\xb5\x13\x0f\x3f\xc5\x5a\x8f\x63\x6e\x63\xc6\xae\xfe\xb5\x4f\xe1\xf8\x4b\xb6\x3c\x21\xc7\x23\xcf\xfb\x05\x94\xe2\xed\x39\x03\xae\x05\xb2\x17\x54\x48\x36\xcd\x1c\xfd\xf1\x1a\xb2\x05\x60\x3e\x0c\x54\xaa\x3e\x9d\x60\xd6\x07\xc3\x88\x1c\x1f\x0d\xfd\x08\x71\x79\x4a\x4f\x43\x57\xe0\x10\xb5\x7a\x1a\x7e\x20\xc7\xbe\xe3\x2d\xfd\xc7\x7d\xde\xda\xe0\x98\x83\x6f\x32\xa3\x3f\x86\x36\x55\xe2\x15\x2b\xc3\x40\xa5\x59\x30\x34\x71\x71\xa3\x19\xf9\xca\x1f\x83\x47\x47\x8d\x4f\x5a\xfd\x0e\x1f\x77\xb0\xb8\x33\x38\xf6\x13\x83\xa2\x02\x55\x45\x2e\x39\xe8\x80\xee\x46\x37\x50\x45\x22\xdf\xa8\xda\x58\x56\xd0\x4a\x11\x7d\xe2\x81\xb5\x92\x80\x19\x27\x54\x96\x6f\x61\xf5\x4d\xca\xb0\xc6\x42\xa4\xd0\xc6\xe3\x19\xc8\x94\xca\xf5\x14\xa0\xeb\x46\x9d\x00\x30\xc3\x42\xa9\x16\xce\x96\x61\x70\x18\x28\xa3\x30\xc4\x0e\xe5\x0b\x3c\x4e\xaf\x02\x14\xa7\xac\xe6\xd8\x76\xb2\x95\x43\xfb\xe4\x38\x32\x62\x59\xb6\x02\xc5\xca\xa7\x81\xc7\x07\x0e\x7a\xee\x21\x50\x10\x59\x6d\x36\x14\x5c\xf4\xbd\x5b\x30\x99\x08\x5e\xa8\xdc\xf0\x9c\x3c\xd7\xe4\x21\x12\x34\xce\x41\x3d\x56\x29\x02\x2f\x2e\x8f\xcc\x62\xc6\xb6\x29\xcf\x98\xf5\x19\xb7\x00\x01\x8c\xbf\xbf\x64\x8d\xc3\x1e\xa1\xe7\xb2\x23\xd5\x71\xfa\x28\xf2\x64\xd7\xae\xea\x0a\x46\x1e\x13\x04\x10\xf6\x7d\x57\x9a\x21\xf1\xe4\x7d\x7f\xd4\x0e\x98\xa6\x52\x21\xeb\xc2\x5e\x29\xf2\x34\x65\x22\x0c\x52\xe8\xff\x33\xcc\x13\x68\x83\x0d\x96\x49\x98\x61\x58\xa4\x92\x32\xb2\x09\x1d\x3d\x4c\xf3\x84\x36\xd8\xa4\x89\x62\x99\x8b\xf2\x4c\x60\xed\x81\x65\x97\xca\xd0\xcf\xd7\x34\xad\xd8\x73\x12\x60\xd6\x81\xdc\x94\xce\x59\x0a\x8f\xa7\xf8\xb8\x8b\x7c\x1a\x0a\x2a\xaf\x79\x79\xeb\xd0\x9d\x00\x2a\x40\x13\xab\x77\xda\x2c\xa0\x36\x73\xc8\xcf\xe0\xd1\x76\x8d\xae\xf8\x2d\x55\x33\x91\x43\x3f\x31\x4b\x4a\x41\x9e\x05\x18\xb3\xab\x91\xef\x11\xf8\x52\xbb\x1b\x4b\x46\x45\xb2\x0e\x87\x97\x07\xb8\x75\x70\x85\x78\xa1\x7d\x73\xb8\x1e\x6e\x69\x09\x44\x01\x92\xb8\xb1\xc4\xe7\x08\xbb\xfc\x35\xcf\xab\x1a\x4f\x55\x99\xa0\x1e\xc0\xe7\xf6\x1e\xe9\xaa\xb6\x52\xf1\xcf\x10\xc0\xb7\x48\x69\xc2\xc2\x1a\x05\xd2\x9c\x2e\xd4\x21\xda\xa5\x9d\xc1\x03\xf8\xa3\xba\xb7\xc1\x01\x44\x58\x9c\x6c\xf0\xd0\x71\x34\x90\x20\x10\x87\x3e\xa9\x5b\x32\x6e\x2d\x61\x46\x59\xa8\xe9\x06\x27\x1a\x9c\x81\x40\x36\x06\x0b\x28\xb4\x34\x64\x86\x11\xa6\xe4\xa9\xdb\xe0\xd7\x14\xa7\xe6\x8c\xcb\x35\x08\x96\xd0\x93\xd5\xfc\x66\x66\xbc\x4d\x0c\xac\x75\x11\x3a\xd6\x1a\x8f\x55\xa2\x29\x20\xb4\x13\xd0\x13\x12\xa0\x90\x0f\x85\xc8\x57\x38\x49\x06\x43\x48\xf7\x24\x81\x5f\x0d\xae\xda\x3d\x27\x6e\xe6\x00\x71\x24\xc0\xb8\xea\x7d\x1b\xa5\x5e\x2d\x0a\x23\x5f\x62\x5c\xc7\x01\x3c\x3b\x29\xd0\x68\xac\xc1\xd8\x69\xd3\xba\x7d\x83\x6a\xc3\x21\xdc\x18\x2b\xdd\xc8\x7a\x62\xab\x6b\x66\x18\x1a\xce\xd1\xae\x3e\x3e\x4c\x06\x6b\x6c\x6c\x63\xe7\x76\x8d\xba\xa7\xd4\xa1\x8b\x7a\xdb\x40\x0d\x01\x5e\x42\xb8\xb1\xff\xb2\x5d\x46\xad\x06\x45\xa0\xc0\xaa\xbe\xd2\x7a\xf0\x34\x43\xdc\xe0\x78\xd9\x68\x73\x10\x4d\xaf\xc7\x22\x80\x37\x95\x76\xb5\xdc\x4b\x7e\x35\x74\x92\xd3\x41\x29\xe0\xd1\x66\xbb\x76\x2a\x5e\x18\x25\x37\x56\x3a\x5e\xcb\x62\xbe\x00\x6b\x70\x15\x7e\x8d\x9a\x65\xd5\xdc\xcc\x86\xfa\x0d\xf5\xd9\xd3\xce\xd4\xbe\xea\x69\x0e\xaf\xd3\x27\xac\x08\x77\x09\x24\xa9\x35\x1c\x46\x37\xec\x82\x97\xa9\x92\x1e\x04\x8e\x88\x92\xae\xd4\x4c\xa3\x08\xd5\x03\x50\xc0\x95\x22\xfe\x35\xe7\x59\x08\x55\x1b\xb8\x0a\xcd\x5c\x36\xae\x65\xe0\xe9\x2b\x48\x9b\x29\x08\x7b\x85\x97\x84\x00\x03\xec\xba\x6f\x5a\xb0\xe5\xbd\x54\xba\x5a\x4c\x98\xce\xc4\x60\xa3\xda\xb7\xd8\x37\x51\x31\x6d\x68\x68\x0b\x00\x23\x67\x3c\x69\xfe\x53\x22\x92\x34\x87\xbb\x75\x5b\x89\x5e\x0d\xae\xdc\x04\x56\xe4\x73\x01\x97\xe4\xb5\x9b\xba\xda\xe4\xb8\xca\xe4\x9a\x2f\x4b\x97\x0a\x24\xe9\x47\x1c\xdd\xc8\x4b\x10\xfb\x37\x82\x25\x58\xaf\xf9\x0d\xee\xbb\x21\x01\xec\x0e\x86\xad\x9c\x77\x94\xa7\xaa\x6d\x4c\x4c\xd7\xe8\x31\x42\x65\x66\x80\x53\x8b\xee\x2d\x4c\xe9\xc3\x54\x7d\x0d\xac\xbd\x42\xe2\x32\x3f\x01\x2c\x4e\xd9\xb9\x36\xa4\xab\xde\x3f\x54\xa3\x49\x9f\x3c\xf9\xa5\x3a\x3a\x9a\x7f\x57\x9f\xbf\x42\x65\x3d\xa3\x7d\xb1\x23\xeb\x6e\xb6\xb7\x27\xfb\xad\xd8\x16\xeb\x9e\xae\x85\x5b\x07\x6e\x87\x33\xb4\xf8\x67\x34\xb8\x03\x18\x3f\x98\xd9\xea\x25\x3e\x28\x7c\xc4\x1f\x2d\x60\xec\x03\x45\x1f\x8f\xd5\x3d\x77\x5c\x03\xc6\x5d\x35\x5a\xd3\x38\x75\xaa\xba\xce\x09\xcf\x3e\xc2\x9d\x5f\x9a\x56\xe4\xcc\xb6\x70\xc5\xcb\x53\x68\x59\xd0\x81\xd6\x4f\xe7\x14\x5f\x05\x4d\x5f\xab\x5e\x56\x15\x0b\x5a\xea\x97\x23\xf8\x66\x07\x6f\xbb\x32\xad\x56\xf1\xc0\xbd\x2f\x19\x7d\x00\x2d\x8f\x1e\x11\xf7\xf1\x81\x0e\x90\x87\xc3\xad\xf0\xd5\xc4\xcd\xbc\xde\x6d\xdd\x48\x0d\x07\xe9\x50\xf7\x74\x70\x17\xc0\xcd\x7d\xdf\xde\x83\x55\x57\xe6\x29\xdc\x67\xf4\x3b\xa0\xa6\xe9\x36\x2f\x21\xa0\xf3\xaa\x26\x5d\x0f\xc5\x8b\x1c\xda\x10\xbe\xd2\x32\x6f\x8f\x54\xd7\x36\xb1\xdb\x10\x9a\xa6\x71\x93\x0d\x5a\x38\x38\xa4\x1d\x75\xe0\xef\x79\x6f\x3a\x39\x04\x66\x16\x32\x08\x62\xde\x0d\x30\xf1\x7c\x4f\x1a\x9a\xed\x36\x17\x5a\xbc\x9f\x49\xef\xfa\x3c\x58\x66\xac\x9f\x41\x6d\xf9\xd4\x98\x4b\xfd\xc4\xb8\x63\x69\x07\xe6\x1e\x65\x47\x00\x94\xa3\xb0\x36\x40\x08\x07\x58\x5d\xe8\x31\x12\x26\x56\x7c\x03\x00\xf8\x17\x61\x95\xe0\x2d\xce\x62\xe1\x3d\x46\xe2\x04\x3a\x89\xe6\xad\xa7\x62\x70\x4f\x96\x9d\xc1\xd5\x24\xef\x57\x8c\xc5\x75\x7c\x1d\x9e\x59\xbd\xf6\xfb\xa6\x5c\x6b\xee\xff\xc8\xa0\x6b\x6d\x37\xa6\xfa\xc3\x6e\x6b\x1b\x9c\xeb\x5c\xc1\x11\x28\x21\x20\x70\x5b\x84\xfe\xfb\xa8\x6f\xea\x6b\x4d\x3a\x05\x15\x74\x83\xcd\xd8\xab\x15\xbf\x2b\xab\xf8\x63\x28\x5d\x92\x4b\xc5\x59\x77\xc8\x8e\xa3\x6a\x3b\x32\xbc\x10\xe9\x0c\x2e\xad\x3d\x0e\x63\xf8\x14\x8d\x0b\x43\xda\x83\x27\xe8\x02\x5a\xaf\x6d\x04\xc8\x56\xbe\xb0\x2c\xc9\x17\xec\xdd\x6c\xfa\x2a\xdf\x00\xe0\x20\xa8\x6a\x01\x2d\xa8\x51\xff\x47\x54\x98\xa4\xa9\x69\x37\x2e\xfc\xe3\x1c\xf1\xc1\x40\x90\x0f\xff\xf0\x47\x19\x10\xf5\x20\x7f\x0b\xf8\x9b\x01\x45\x3a\x78\x39\x72\xb0\xee\x2e\x13\xf4\x94\xf1\x87\x8d\x70\x47\x98\x7d\x66\xec\x3a\xd9\xd3\x1e\x9c\xe9\x62\xf1\x96\xae\xd8\x14\x66\x04\x19\xe2\x2b\xe0\xc8\x02\xb4\x3d\x19\x7f\x3a\x46\x92\x18\x27\x0a\xe9\x27\x0b\x32\x49\x3d\x6c\x36\x24\x30\x1c\xc7\x76\x63\xe4\x10\xab\x17\xbc\x25\xdd\x14\x3d\xd4\xaa\xae\xe3\x9a\xc2\x65\x43\x80\xde\xcb\xe1\xa0\xf7\xa8\x9e\xdb\x95\x23\xee\xe4\x8e\x0b\xa1\x35\x29\x6a\x0c\x89\x94\xf0\xa8\xf3\x22\x65\xe8\x8d\x38\xde\x6d\xaf\x19\x67\xec\x65\xe3\xad\xbe\x84\xe4\x22\xc4\x76\xfd\x6f\x7d\x8e\x30\x64\xa5\x73\x9a\x7c\xf4\x2f\x20\xf6\xd0\xea\x2b\x48\xeb\x22\x03\x32\xba\x63\x87\x77\x56\x76\xbb\x39\xaf\x51\x5f\xff\xcf\xd8\x4d\x89\x4c\x17\xf9\x47\xe6\xbd\x7c\x6d\x86\xa3\xda\x58\x4c\xce\x47\x18\xdd\x7d\x05\xb7\x47\x6a\x33\x38\xd5\xd6\x37\x55\x49\x58\x0a\x33\x4a\xa3\xd7\xc6\x23\x6c\x9b\xbd\xeb\x5e\xa2\x6a\x71\xa3\xde\xf0\x3b\xe5\x75\x47\xc0\x7b\x1c\x6d\x0c\xfe\xea\x53\x1c\xf6\xdd\xe9\xbe\x94\x55\x66\xdc\xf4\xee\x7c\x4e\xc1\xf8\x25\xa2\xef\x5e\x4e\x85\xb4\x6a\xa1\xbe\xde\x39\x6f\xf5\xec\xb8\x6e\x5e\x19\x8e\xad\xc6\xd1\xbd\x26\x73\x88\x8d\xf6\xe1\x4f\x9f\xcb\x9b\x64\xdf\x4b\x8a\x9b\x66\x88\xff\xff\xb8\x7e\xcf\x71\xfd\x8f\xcf\xe2\x7b\x62\x8d\x87\xf1\x61\xc1\x4a\xca\xd3\x6e\x63\x0a\x1e\x69\x02\xb3\x86\x3f\xbf\xfa\x0c\x8c\xec\x4e\xbb\xd2\xaf\x76\xce\x33\x5e\x14\xac\x6c\xc0\x2d\x36\x9f\xc5\x2c\x4a\xdc\xc3\x78\xbe\x5c\xfe\x49\x96\x5b\x93\x41\xa2\x6b\xef\xae\xd5\x41\x3d\xc3\x8d\xbd\x17\x6b\x7c\xb1\x22\x5d\xcc\x37\x3b\x27\xe6\xe8\x30\x04\x9f\x77\x7d\xef\x9f\xfa\x45\x78\x42\xf4\x1e\x48\xf0\x69\xa1\x1d\xba\x3d\xe0\xc0\xa6\xc9\x41\x47\xac\x8d\x6b\xfb\x6b\x9c\xe5\x68\x8b\xb6\xf4\xb1\x25\xf0\xbf\x3d\x85\x07\xb8\xcf\x4b\xa5\xa8\x81\x0a\xc8\xe4\x50\x5f\x45\xbc\x75\xff\xbb\x91\x75\x8b\xe3\xd4\x5e\x27\xb6\x5e\x19\xb5\xe8\x4c\x05\xd7\x54\xfa\xdb\x89\xf3\x6a\x07\x6c\x79\x10\x1a\x69\x8d\xcf\x75\xcc\x5b\xaa\x49\x87\xe0\x52\xf3\x5e\x39\x87\xd3\xfe\x1c\xe6\x5a\x8c\x90\x21\x9b\x58\x75\xc4\x74\x6d\x53\x1e\x18\xcb\x0c\x7f\xaf\x51\x66\xef\x12\xe9\xbf\x6c\x0d\x52\x01\x32\x31\x6b\x8b\xc7\xdd\x36\xe2\x40\xd0\x6c\xc5\x5a\x47\xe5\x5b\xa0\x60\x1b\xa9\xdc\x60\xab\x85\x91\x47\xa7\xc4\x41\x6b\x12\x25\x6a\x57\x22\x15\x55\x5b\x9e\x49\x2e\xa0\xc1\x42\x42\x8a\xb8\xe6\x1a\xb5\x28\x75\x9c\x14\x2d\xcf\x1a\xcf\x86\x5d\x99\xa4\xd9\xbd\x44\xfa\x2b\x67\x9e\xea\x8f\x95\x6b\x89\xa9\x19\xf3\xcd\xcf\x91\xd2\x16\xe0\x10\xeb\x49\xd2\xaf\x0c\xc4\xfb\xa1\xcf\xb3\xeb\x3d\xaa\x5d\x6b\xce\xf1\x4b\xff\x8e\xa4\xed\x20\xc0\xfd\x13\xcf\xb9\xef\x75\xf3\xae\x55\xfb\xf7\x4c\x22\xdd\xd2\xf1\xa3\x34\x8e\x19\x1a\xf7\x66\xea\x59\x25\x77\x64\x78\xa3\x46\x5e\xd4\x86\xb4\x51\xcf\x10\xda\x6e\xf9\x38\x9f\x20\xf0\xf7\x60\xb9\x56\xa0\xd6\x4c\xd4\x70\x15\xff\x3d\xc5\x58\x5f\x1b\xa1\x65\xd7\xe8\xae\x4d\xed\x19\x32\xfd\x19\xee\xf7\x39\xe2\x7f\x01\xbe\xa3\xfb\x99\x7f\xe9\x80\x1e\xd9\x10\xd7\x2d\x4e\x16\x29\x2f\x9b\xef\xae\xde\xb1\x75\xcb\xc0\x39\x34\xac\x14\x45\xf0\x82\x1c\x21\xd6\xaa\xdf\x3f\x8c\x3d\x45\xf6\xa3\x73\x07\x72\x55\xfd\xc1\x14\x73\xa2\x8b\xf2\x5f\xf8\x81\x7a\x43\x6f\xf0\x8d\xb4\x92\xf3\x94\x7c\x33\x6c\xc3\xaf\x7e\x55\xb4\x9f\xe3\xb8\xc3\x61\xc2\x6a\x7d\xf6\xab\xd3\x69\x7b\x63\xcf\x9e\x11\xac\x80\x1f\x8d\x3a\x58\x78\xf2\xa4\x0d\x00\xae\xec\xe6\x8a\x77\xde\xac\x86\x1c\x3f\xbd\xbb\xd1\x70\x3e\xd6\xf4\x03\xa9\x91\x09\xf6\x38\xb2\x42\x37\x11\x22\x4f\xef\xb0\xd7\x9f\xb5\x77\x64\x1a\x55\xfa\x20\x16\xa1\xa3\x8b\x3f\x97\xeb\x16\x04\xdd\x6f\x3c\x68\x77\xa7\x7b\x8d\x09\x6e\x2a\x75\x61\x8d\x8c\xc7\xca\xc6\x3e\xe8\xf5\xa9\x9b\xb8\x99\x5f\x77\xa3\xf0\xbd\x00\xb2\x7b\xd1\x72\xcf\x16\x43\x75\xaa\xfe\xc5\x58\x7d\xc4\xd2\xbb\x5f\x35\x04\xd8\xc4\xea\x07\xe7\xb6\x64\xd9\x48\x5d\x32\x72\xaf\xce\x56\x0e\xa4\x6e\x2d\x1a\x61\x66\x84\x71\x27\x17\xb5\xb3\x14\x1c\x32\x39\xbd\x7d\xe5\x53\x78\x5f\x81\xbe\x75\xbf\xa4\x99\x29\xa7\x19\x6e\x6a\x7f\xa4\x71\x45\x36\xf7\xba\xff\x02\x50\x8b\xbc\x3f\x5b\x2a\x00\x00")
 
 func assets_reviews_js() ([]byte, error) {
 	return bindata_read(